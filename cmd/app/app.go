@@ -1,7 +1,45 @@
 package main
 
-import serviceApp "dormitory-helper-service/internal/app/service"
+import (
+	"context"
+	"dormitory-helper-service/internal/app/lifecycle"
+	serviceApp "dormitory-helper-service/internal/app/service"
+	"dormitory-helper-service/internal/config"
+	"dormitory-helper-service/internal/logging"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+)
 
 func main() {
-	serviceApp.Run()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		ctx, stop := lifecycle.SignalContext(context.Background())
+		err := runMigrate(ctx, os.Args[2:])
+		stop()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", err)
+		os.Exit(2)
+	}
+
+	ctx, stop := lifecycle.SignalContext(context.Background())
+	defer stop()
+
+	if err := serviceApp.Run(ctx, cfg); err != nil {
+		slog.Error("application stopped with error", logging.Err(err))
+		stop()
+		os.Exit(1)
+	}
 }
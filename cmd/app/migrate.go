@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"dormitory-helper-service/internal/config"
+	"dormitory-helper-service/internal/logging"
+	"dormitory-helper-service/internal/migrator"
+	"errors"
+	"fmt"
+	"os"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const migrateUsage = "usage: dormitory-helper migrate up|down|status|version [flags]"
+
+// runMigrate выполняет команду migrate. Нужна только конфигурация базы данных
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	command := args[0]
+
+	cfg, err := config.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	if err := cfg.DatabaseConfig.Validate(); err != nil {
+		return err
+	}
+	if err := logging.Setup(os.Stderr, cfg.LogConfig.Format, cfg.LogConfig.Level); err != nil {
+		return err
+	}
+
+	db, err := sql.Open("pgx", cfg.DatabaseConfig.URL())
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer db.Close()
+
+	m, err := migrator.New(db)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		return m.Up(ctx)
+	case "down":
+		return m.Down(ctx)
+	case "status":
+		return m.WriteStatus(ctx, os.Stdout)
+	case "version":
+		current, latest, err := m.Versions(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("current: %d\nlatest: %d\n", current, latest)
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", command, migrateUsage)
+	}
+}
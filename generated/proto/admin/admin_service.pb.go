@@ -0,0 +1,1783 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: admin/admin_service.proto
+
+package admin
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Роль пользователя
+type UserRole int32
+
+const (
+	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
+	UserRole_USER_ROLE_USER        UserRole = 1
+	UserRole_USER_ROLE_ADMIN       UserRole = 2
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_USER",
+		2: "USER_ROLE_ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED": 0,
+		"USER_ROLE_USER":        1,
+		"USER_ROLE_ADMIN":       2,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_admin_service_proto_enumTypes[0].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_admin_admin_service_proto_enumTypes[0]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Запись с данными владельца и ресурса
+type AdminBooking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceId    int32                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceName  string                 `protobuf:"bytes,4,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	UserId        int32                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminBooking) Reset() {
+	*x = AdminBooking{}
+	mi := &file_admin_admin_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBooking) ProtoMessage() {}
+
+func (x *AdminBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBooking.ProtoReflect.Descriptor instead.
+func (*AdminBooking) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminBooking) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminBooking) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *AdminBooking) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AdminBooking) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *AdminBooking) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminBooking) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminBooking) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AdminBooking) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// Сообщение для получения всех записей
+type ListAllBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  *string                `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllBookingsRequest) Reset() {
+	*x = ListAllBookingsRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllBookingsRequest) ProtoMessage() {}
+
+func (x *ListAllBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAllBookingsRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAllBookingsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAllBookingsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListAllBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*AdminBooking        `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllBookingsResponse) Reset() {
+	*x = ListAllBookingsResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllBookingsResponse) ProtoMessage() {}
+
+func (x *ListAllBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAllBookingsResponse) GetBookings() []*AdminBooking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// Сообщение для принудительного удаления записи
+type ForceDeleteBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteBookingRequest) Reset() {
+	*x = ForceDeleteBookingRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteBookingRequest) ProtoMessage() {}
+
+func (x *ForceDeleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*ForceDeleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForceDeleteBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type ForceDeleteBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceDeleteBookingResponse) Reset() {
+	*x = ForceDeleteBookingResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceDeleteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceDeleteBookingResponse) ProtoMessage() {}
+
+func (x *ForceDeleteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceDeleteBookingResponse.ProtoReflect.Descriptor instead.
+func (*ForceDeleteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ForceDeleteBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для переноса записи на другое время или другой ресурс
+type MoveBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ResourceId    *int32                 `protobuf:"varint,4,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveBookingRequest) Reset() {
+	*x = MoveBookingRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBookingRequest) ProtoMessage() {}
+
+func (x *MoveBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBookingRequest.ProtoReflect.Descriptor instead.
+func (*MoveBookingRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MoveBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *MoveBookingRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MoveBookingRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MoveBookingRequest) GetResourceId() int32 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+type MoveBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveBookingResponse) Reset() {
+	*x = MoveBookingResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBookingResponse) ProtoMessage() {}
+
+func (x *MoveBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBookingResponse.ProtoReflect.Descriptor instead.
+func (*MoveBookingResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MoveBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для запрета бронирования пользователю
+type BanUserRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Если не указано - бессрочно
+	BannedUntil   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=banned_until,json=bannedUntil,proto3,oneof" json:"banned_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BanUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetBannedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return nil
+}
+
+type BanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanUserResponse) Reset() {
+	*x = BanUserResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserResponse) ProtoMessage() {}
+
+func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
+func (*BanUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BanUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для снятия запрета бронирования
+type UnbanUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserRequest) Reset() {
+	*x = UnbanUserRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserRequest) ProtoMessage() {}
+
+func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnbanUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnbanUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanUserResponse) Reset() {
+	*x = UnbanUserResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanUserResponse) ProtoMessage() {}
+
+func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
+func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnbanUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для назначения роли пользователю
+type SetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=role,proto3,enum=admin.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetUserRoleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetUserRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type SetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleResponse) Reset() {
+	*x = SetUserRoleResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleResponse) ProtoMessage() {}
+
+func (x *SetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetUserRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запуск фоновой задачи
+type JobRun struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// success, failed или skipped (задача выполнялась на другой реплике)
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_admin_admin_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *JobRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Состояние фоновой задачи на реплике, обработавшей запрос
+type Job struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	LastError       string                 `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_error_at,json=lastErrorAt,proto3,oneof" json:"last_error_at,omitempty"`
+	// Последние запуски, от новых к старым
+	Runs          []*JobRun `protobuf:"bytes,5,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_admin_admin_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetLastErrorAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return nil
+}
+
+func (x *Job) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// Сообщение для получения состояния фоновых задач
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{15}
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// Закрытие ресурса администратором (ремонт, санобработка, праздники)
+type Blackout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceId    int32                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     int32                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Blackout) Reset() {
+	*x = Blackout{}
+	mi := &file_admin_admin_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Blackout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blackout) ProtoMessage() {}
+
+func (x *Blackout) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blackout.ProtoReflect.Descriptor instead.
+func (*Blackout) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Blackout) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Blackout) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *Blackout) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Blackout) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Blackout) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Blackout) GetCreatedBy() int32 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+// Сообщение для закрытия ресурса на период
+type CreateBlackoutRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId int32                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason     string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Отменить существующие записи, пересекающие период
+	CancelBookings bool `protobuf:"varint,5,opt,name=cancel_bookings,json=cancelBookings,proto3" json:"cancel_bookings,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutRequest) Reset() {
+	*x = CreateBlackoutRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutRequest) ProtoMessage() {}
+
+func (x *CreateBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateBlackoutRequest) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *CreateBlackoutRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateBlackoutRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateBlackoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetCancelBookings() bool {
+	if x != nil {
+		return x.CancelBookings
+	}
+	return false
+}
+
+type CreateBlackoutResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BlackoutId        int32                  `protobuf:"varint,1,opt,name=blackout_id,json=blackoutId,proto3" json:"blackout_id,omitempty"`
+	CancelledBookings int32                  `protobuf:"varint,2,opt,name=cancelled_bookings,json=cancelledBookings,proto3" json:"cancelled_bookings,omitempty"`
+	Message           string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutResponse) Reset() {
+	*x = CreateBlackoutResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutResponse) ProtoMessage() {}
+
+func (x *CreateBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateBlackoutResponse) GetBlackoutId() int32 {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return 0
+}
+
+func (x *CreateBlackoutResponse) GetCancelledBookings() int32 {
+	if x != nil {
+		return x.CancelledBookings
+	}
+	return 0
+}
+
+func (x *CreateBlackoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для снятия закрытия
+type DeleteBlackoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlackoutId    int32                  `protobuf:"varint,1,opt,name=blackout_id,json=blackoutId,proto3" json:"blackout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlackoutRequest) Reset() {
+	*x = DeleteBlackoutRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutRequest) ProtoMessage() {}
+
+func (x *DeleteBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteBlackoutRequest) GetBlackoutId() int32 {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return 0
+}
+
+type DeleteBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBlackoutResponse) Reset() {
+	*x = DeleteBlackoutResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutResponse) ProtoMessage() {}
+
+func (x *DeleteBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteBlackoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для получения закрытий, пересекающих промежуток
+type ListBlackoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    *int32                 `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlackoutsRequest) Reset() {
+	*x = ListBlackoutsRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlackoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutsRequest) ProtoMessage() {}
+
+func (x *ListBlackoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlackoutsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListBlackoutsRequest) GetResourceId() int32 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *ListBlackoutsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListBlackoutsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListBlackoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blackouts     []*Blackout            `protobuf:"bytes,1,rep,name=blackouts,proto3" json:"blackouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlackoutsResponse) Reset() {
+	*x = ListBlackoutsResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlackoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutsResponse) ProtoMessage() {}
+
+func (x *ListBlackoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlackoutsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListBlackoutsResponse) GetBlackouts() []*Blackout {
+	if x != nil {
+		return x.Blackouts
+	}
+	return nil
+}
+
+// Сообщение для выдачи ресурсу нового QR-кода отметки о приходе
+type RotateCheckInTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int32                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCheckInTokenRequest) Reset() {
+	*x = RotateCheckInTokenRequest{}
+	mi := &file_admin_admin_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCheckInTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCheckInTokenRequest) ProtoMessage() {}
+
+func (x *RotateCheckInTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCheckInTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateCheckInTokenRequest) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RotateCheckInTokenRequest) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+type RotateCheckInTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Токен для QR-кода. Прежний токен перестает действовать
+	CheckInToken  string `protobuf:"bytes,1,opt,name=check_in_token,json=checkInToken,proto3" json:"check_in_token,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCheckInTokenResponse) Reset() {
+	*x = RotateCheckInTokenResponse{}
+	mi := &file_admin_admin_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCheckInTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCheckInTokenResponse) ProtoMessage() {}
+
+func (x *RotateCheckInTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCheckInTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateCheckInTokenResponse) Descriptor() ([]byte, []int) {
+	return file_admin_admin_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RotateCheckInTokenResponse) GetCheckInToken() string {
+	if x != nil {
+		return x.CheckInToken
+	}
+	return ""
+}
+
+func (x *RotateCheckInTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_admin_admin_service_proto protoreflect.FileDescriptor
+
+const file_admin_admin_service_proto_rawDesc = "" +
+	"\n" +
+	"\x19admin/admin_service.proto\x12\x05admin\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xb0\x02\n" +
+	"\fAdminBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x05R\n" +
+	"resourceId\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12#\n" +
+	"\rresource_name\x18\x04 \x01(\tR\fresourceName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\xec\x01\n" +
+	"\x16ListAllBookingsRequest\x12(\n" +
+	"\rresource_type\x18\x01 \x01(\tH\x00R\fresourceType\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendTime\x88\x01\x01B\x10\n" +
+	"\x0e_resource_typeB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"J\n" +
+	"\x17ListAllBookingsResponse\x12/\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.admin.AdminBookingR\bbookings\":\n" +
+	"\x19ForceDeleteBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\"6\n" +
+	"\x1aForceDeleteBookingResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xdb\x01\n" +
+	"\x12MoveBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12$\n" +
+	"\vresource_id\x18\x04 \x01(\x05H\x00R\n" +
+	"resourceId\x88\x01\x01B\x0e\n" +
+	"\f_resource_id\"/\n" +
+	"\x13MoveBookingResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x96\x01\n" +
+	"\x0eBanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12B\n" +
+	"\fbanned_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vbannedUntil\x88\x01\x01B\x0f\n" +
+	"\r_banned_until\"+\n" +
+	"\x0fBanUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"+\n" +
+	"\x10UnbanUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"-\n" +
+	"\x11UnbanUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"R\n" +
+	"\x12SetUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12#\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x0f.admin.UserRoleR\x04role\"/\n" +
+	"\x13SetUserRoleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xae\x01\n" +
+	"\x06JobRun\x129\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xdd\x01\n" +
+	"\x03Job\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x10interval_seconds\x18\x02 \x01(\x03R\x0fintervalSeconds\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tR\tlastError\x12C\n" +
+	"\rlast_error_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vlastErrorAt\x88\x01\x01\x12!\n" +
+	"\x04runs\x18\x05 \x03(\v2\r.admin.JobRunR\x04runsB\x10\n" +
+	"\x0e_last_error_at\"\x11\n" +
+	"\x0fListJobsRequest\"2\n" +
+	"\x10ListJobsResponse\x12\x1e\n" +
+	"\x04jobs\x18\x01 \x03(\v2\n" +
+	".admin.JobR\x04jobs\"\xe4\x01\n" +
+	"\bBlackout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x05R\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\x05R\tcreatedBy\"\xeb\x01\n" +
+	"\x15CreateBlackoutRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x05R\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12'\n" +
+	"\x0fcancel_bookings\x18\x05 \x01(\bR\x0ecancelBookings\"\x82\x01\n" +
+	"\x16CreateBlackoutResponse\x12\x1f\n" +
+	"\vblackout_id\x18\x01 \x01(\x05R\n" +
+	"blackoutId\x12-\n" +
+	"\x12cancelled_bookings\x18\x02 \x01(\x05R\x11cancelledBookings\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"8\n" +
+	"\x15DeleteBlackoutRequest\x12\x1f\n" +
+	"\vblackout_id\x18\x01 \x01(\x05R\n" +
+	"blackoutId\"2\n" +
+	"\x16DeleteBlackoutResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xe4\x01\n" +
+	"\x14ListBlackoutsRequest\x12$\n" +
+	"\vresource_id\x18\x01 \x01(\x05H\x00R\n" +
+	"resourceId\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendTime\x88\x01\x01B\x0e\n" +
+	"\f_resource_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"F\n" +
+	"\x15ListBlackoutsResponse\x12-\n" +
+	"\tblackouts\x18\x01 \x03(\v2\x0f.admin.BlackoutR\tblackouts\"<\n" +
+	"\x19RotateCheckInTokenRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x05R\n" +
+	"resourceId\"\\\n" +
+	"\x1aRotateCheckInTokenResponse\x12$\n" +
+	"\x0echeck_in_token\x18\x01 \x01(\tR\fcheckInToken\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*N\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x022\xa0\n" +
+	"\n" +
+	"\fAdminService\x12p\n" +
+	"\x0fListAllBookings\x12\x1d.admin.ListAllBookingsRequest\x1a\x1e.admin.ListAllBookingsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/admin/bookings\x12\x86\x01\n" +
+	"\x12ForceDeleteBooking\x12 .admin.ForceDeleteBookingRequest\x1a!.admin.ForceDeleteBookingResponse\"+\x82\xd3\xe4\x93\x02%*#/api/v1/admin/bookings/{booking_id}\x12y\n" +
+	"\vMoveBooking\x12\x19.admin.MoveBookingRequest\x1a\x1a.admin.MoveBookingResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/admin/bookings/{booking_id}/move\x12f\n" +
+	"\aBanUser\x12\x15.admin.BanUserRequest\x1a\x16.admin.BanUserResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/users/{user_id}/ban\x12i\n" +
+	"\tUnbanUser\x12\x17.admin.UnbanUserRequest\x1a\x18.admin.UnbanUserResponse\")\x82\xd3\xe4\x93\x02#*!/api/v1/admin/users/{user_id}/ban\x12s\n" +
+	"\vSetUserRole\x12\x19.admin.SetUserRoleRequest\x1a\x1a.admin.SetUserRoleResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/v1/admin/users/{user_id}/role\x12W\n" +
+	"\bListJobs\x12\x16.admin.ListJobsRequest\x1a\x17.admin.ListJobsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/admin/jobs\x12q\n" +
+	"\x0eCreateBlackout\x12\x1c.admin.CreateBlackoutRequest\x1a\x1d.admin.CreateBlackoutResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/admin/blackouts\x12|\n" +
+	"\x0eDeleteBlackout\x12\x1c.admin.DeleteBlackoutRequest\x1a\x1d.admin.DeleteBlackoutResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/admin/blackouts/{blackout_id}\x12k\n" +
+	"\rListBlackouts\x12\x1b.admin.ListBlackoutsRequest\x1a\x1c.admin.ListBlackoutsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/admin/blackouts\x12\x9a\x01\n" +
+	"\x12RotateCheckInToken\x12 .admin.RotateCheckInTokenRequest\x1a!.admin.RotateCheckInTokenResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/admin/resources/{resource_id}/check-in-tokenB6Z4dormitory-helper-service/generated/proto/admin;adminb\x06proto3"
+
+var (
+	file_admin_admin_service_proto_rawDescOnce sync.Once
+	file_admin_admin_service_proto_rawDescData []byte
+)
+
+func file_admin_admin_service_proto_rawDescGZIP() []byte {
+	file_admin_admin_service_proto_rawDescOnce.Do(func() {
+		file_admin_admin_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_admin_service_proto_rawDesc), len(file_admin_admin_service_proto_rawDesc)))
+	})
+	return file_admin_admin_service_proto_rawDescData
+}
+
+var file_admin_admin_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_admin_admin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_admin_admin_service_proto_goTypes = []any{
+	(UserRole)(0),                      // 0: admin.UserRole
+	(*AdminBooking)(nil),               // 1: admin.AdminBooking
+	(*ListAllBookingsRequest)(nil),     // 2: admin.ListAllBookingsRequest
+	(*ListAllBookingsResponse)(nil),    // 3: admin.ListAllBookingsResponse
+	(*ForceDeleteBookingRequest)(nil),  // 4: admin.ForceDeleteBookingRequest
+	(*ForceDeleteBookingResponse)(nil), // 5: admin.ForceDeleteBookingResponse
+	(*MoveBookingRequest)(nil),         // 6: admin.MoveBookingRequest
+	(*MoveBookingResponse)(nil),        // 7: admin.MoveBookingResponse
+	(*BanUserRequest)(nil),             // 8: admin.BanUserRequest
+	(*BanUserResponse)(nil),            // 9: admin.BanUserResponse
+	(*UnbanUserRequest)(nil),           // 10: admin.UnbanUserRequest
+	(*UnbanUserResponse)(nil),          // 11: admin.UnbanUserResponse
+	(*SetUserRoleRequest)(nil),         // 12: admin.SetUserRoleRequest
+	(*SetUserRoleResponse)(nil),        // 13: admin.SetUserRoleResponse
+	(*JobRun)(nil),                     // 14: admin.JobRun
+	(*Job)(nil),                        // 15: admin.Job
+	(*ListJobsRequest)(nil),            // 16: admin.ListJobsRequest
+	(*ListJobsResponse)(nil),           // 17: admin.ListJobsResponse
+	(*Blackout)(nil),                   // 18: admin.Blackout
+	(*CreateBlackoutRequest)(nil),      // 19: admin.CreateBlackoutRequest
+	(*CreateBlackoutResponse)(nil),     // 20: admin.CreateBlackoutResponse
+	(*DeleteBlackoutRequest)(nil),      // 21: admin.DeleteBlackoutRequest
+	(*DeleteBlackoutResponse)(nil),     // 22: admin.DeleteBlackoutResponse
+	(*ListBlackoutsRequest)(nil),       // 23: admin.ListBlackoutsRequest
+	(*ListBlackoutsResponse)(nil),      // 24: admin.ListBlackoutsResponse
+	(*RotateCheckInTokenRequest)(nil),  // 25: admin.RotateCheckInTokenRequest
+	(*RotateCheckInTokenResponse)(nil), // 26: admin.RotateCheckInTokenResponse
+	(*timestamppb.Timestamp)(nil),      // 27: google.protobuf.Timestamp
+}
+var file_admin_admin_service_proto_depIdxs = []int32{
+	27, // 0: admin.AdminBooking.start_time:type_name -> google.protobuf.Timestamp
+	27, // 1: admin.AdminBooking.end_time:type_name -> google.protobuf.Timestamp
+	27, // 2: admin.ListAllBookingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	27, // 3: admin.ListAllBookingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 4: admin.ListAllBookingsResponse.bookings:type_name -> admin.AdminBooking
+	27, // 5: admin.MoveBookingRequest.start_time:type_name -> google.protobuf.Timestamp
+	27, // 6: admin.MoveBookingRequest.end_time:type_name -> google.protobuf.Timestamp
+	27, // 7: admin.BanUserRequest.banned_until:type_name -> google.protobuf.Timestamp
+	0,  // 8: admin.SetUserRoleRequest.role:type_name -> admin.UserRole
+	27, // 9: admin.JobRun.started_at:type_name -> google.protobuf.Timestamp
+	27, // 10: admin.JobRun.finished_at:type_name -> google.protobuf.Timestamp
+	27, // 11: admin.Job.last_error_at:type_name -> google.protobuf.Timestamp
+	14, // 12: admin.Job.runs:type_name -> admin.JobRun
+	15, // 13: admin.ListJobsResponse.jobs:type_name -> admin.Job
+	27, // 14: admin.Blackout.start_time:type_name -> google.protobuf.Timestamp
+	27, // 15: admin.Blackout.end_time:type_name -> google.protobuf.Timestamp
+	27, // 16: admin.CreateBlackoutRequest.start_time:type_name -> google.protobuf.Timestamp
+	27, // 17: admin.CreateBlackoutRequest.end_time:type_name -> google.protobuf.Timestamp
+	27, // 18: admin.ListBlackoutsRequest.start_time:type_name -> google.protobuf.Timestamp
+	27, // 19: admin.ListBlackoutsRequest.end_time:type_name -> google.protobuf.Timestamp
+	18, // 20: admin.ListBlackoutsResponse.blackouts:type_name -> admin.Blackout
+	2,  // 21: admin.AdminService.ListAllBookings:input_type -> admin.ListAllBookingsRequest
+	4,  // 22: admin.AdminService.ForceDeleteBooking:input_type -> admin.ForceDeleteBookingRequest
+	6,  // 23: admin.AdminService.MoveBooking:input_type -> admin.MoveBookingRequest
+	8,  // 24: admin.AdminService.BanUser:input_type -> admin.BanUserRequest
+	10, // 25: admin.AdminService.UnbanUser:input_type -> admin.UnbanUserRequest
+	12, // 26: admin.AdminService.SetUserRole:input_type -> admin.SetUserRoleRequest
+	16, // 27: admin.AdminService.ListJobs:input_type -> admin.ListJobsRequest
+	19, // 28: admin.AdminService.CreateBlackout:input_type -> admin.CreateBlackoutRequest
+	21, // 29: admin.AdminService.DeleteBlackout:input_type -> admin.DeleteBlackoutRequest
+	23, // 30: admin.AdminService.ListBlackouts:input_type -> admin.ListBlackoutsRequest
+	25, // 31: admin.AdminService.RotateCheckInToken:input_type -> admin.RotateCheckInTokenRequest
+	3,  // 32: admin.AdminService.ListAllBookings:output_type -> admin.ListAllBookingsResponse
+	5,  // 33: admin.AdminService.ForceDeleteBooking:output_type -> admin.ForceDeleteBookingResponse
+	7,  // 34: admin.AdminService.MoveBooking:output_type -> admin.MoveBookingResponse
+	9,  // 35: admin.AdminService.BanUser:output_type -> admin.BanUserResponse
+	11, // 36: admin.AdminService.UnbanUser:output_type -> admin.UnbanUserResponse
+	13, // 37: admin.AdminService.SetUserRole:output_type -> admin.SetUserRoleResponse
+	17, // 38: admin.AdminService.ListJobs:output_type -> admin.ListJobsResponse
+	20, // 39: admin.AdminService.CreateBlackout:output_type -> admin.CreateBlackoutResponse
+	22, // 40: admin.AdminService.DeleteBlackout:output_type -> admin.DeleteBlackoutResponse
+	24, // 41: admin.AdminService.ListBlackouts:output_type -> admin.ListBlackoutsResponse
+	26, // 42: admin.AdminService.RotateCheckInToken:output_type -> admin.RotateCheckInTokenResponse
+	32, // [32:43] is the sub-list for method output_type
+	21, // [21:32] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_admin_admin_service_proto_init() }
+func file_admin_admin_service_proto_init() {
+	if File_admin_admin_service_proto != nil {
+		return
+	}
+	file_admin_admin_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_admin_admin_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_admin_admin_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_admin_admin_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_admin_admin_service_proto_msgTypes[22].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_admin_service_proto_rawDesc), len(file_admin_admin_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_admin_service_proto_goTypes,
+		DependencyIndexes: file_admin_admin_service_proto_depIdxs,
+		EnumInfos:         file_admin_admin_service_proto_enumTypes,
+		MessageInfos:      file_admin_admin_service_proto_msgTypes,
+	}.Build()
+	File_admin_admin_service_proto = out.File
+	file_admin_admin_service_proto_goTypes = nil
+	file_admin_admin_service_proto_depIdxs = nil
+}
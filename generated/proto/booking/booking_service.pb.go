@@ -0,0 +1,2572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: booking/booking_service.proto
+
+package booking
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Что отменить в серии повторяющихся записей
+type SeriesCancelScope int32
+
+const (
+	SeriesCancelScope_SERIES_CANCEL_SCOPE_UNSPECIFIED SeriesCancelScope = 0
+	// Одно повторение (booking_id)
+	SeriesCancelScope_SERIES_CANCEL_SCOPE_OCCURRENCE SeriesCancelScope = 1
+	// Повторение booking_id и все следующие
+	SeriesCancelScope_SERIES_CANCEL_SCOPE_FOLLOWING SeriesCancelScope = 2
+	// Все еще не начавшиеся повторения
+	SeriesCancelScope_SERIES_CANCEL_SCOPE_ALL SeriesCancelScope = 3
+)
+
+// Enum value maps for SeriesCancelScope.
+var (
+	SeriesCancelScope_name = map[int32]string{
+		0: "SERIES_CANCEL_SCOPE_UNSPECIFIED",
+		1: "SERIES_CANCEL_SCOPE_OCCURRENCE",
+		2: "SERIES_CANCEL_SCOPE_FOLLOWING",
+		3: "SERIES_CANCEL_SCOPE_ALL",
+	}
+	SeriesCancelScope_value = map[string]int32{
+		"SERIES_CANCEL_SCOPE_UNSPECIFIED": 0,
+		"SERIES_CANCEL_SCOPE_OCCURRENCE":  1,
+		"SERIES_CANCEL_SCOPE_FOLLOWING":   2,
+		"SERIES_CANCEL_SCOPE_ALL":         3,
+	}
+)
+
+func (x SeriesCancelScope) Enum() *SeriesCancelScope {
+	p := new(SeriesCancelScope)
+	*p = x
+	return p
+}
+
+func (x SeriesCancelScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeriesCancelScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_booking_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SeriesCancelScope) Type() protoreflect.EnumType {
+	return &file_booking_booking_service_proto_enumTypes[0]
+}
+
+func (x SeriesCancelScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeriesCancelScope.Descriptor instead.
+func (SeriesCancelScope) EnumDescriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Дополнительные правила бронирования ресурса
+type ResourceRules struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MinDurationMinutes int32                  `protobuf:"varint,1,opt,name=min_duration_minutes,json=minDurationMinutes,proto3" json:"min_duration_minutes,omitempty"`
+	SlotStepMinutes    int32                  `protobuf:"varint,2,opt,name=slot_step_minutes,json=slotStepMinutes,proto3" json:"slot_step_minutes,omitempty"`
+	// Через сколько минут после начала освобождается запись без отметки о приходе.
+	// 0 - отмечаться не нужно
+	CheckInGraceMinutes int32 `protobuf:"varint,3,opt,name=check_in_grace_minutes,json=checkInGraceMinutes,proto3" json:"check_in_grace_minutes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ResourceRules) Reset() {
+	*x = ResourceRules{}
+	mi := &file_booking_booking_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRules) ProtoMessage() {}
+
+func (x *ResourceRules) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRules.ProtoReflect.Descriptor instead.
+func (*ResourceRules) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResourceRules) GetMinDurationMinutes() int32 {
+	if x != nil {
+		return x.MinDurationMinutes
+	}
+	return 0
+}
+
+func (x *ResourceRules) GetSlotStepMinutes() int32 {
+	if x != nil {
+		return x.SlotStepMinutes
+	}
+	return 0
+}
+
+func (x *ResourceRules) GetCheckInGraceMinutes() int32 {
+	if x != nil {
+		return x.CheckInGraceMinutes
+	}
+	return 0
+}
+
+// Бронируемый ресурс (прачечная, кухня, душ и т.д.)
+type Resource struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type               string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MaxDurationMinutes int32                  `protobuf:"varint,4,opt,name=max_duration_minutes,json=maxDurationMinutes,proto3" json:"max_duration_minutes,omitempty"`
+	Capacity           int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Rules              *ResourceRules         `protobuf:"bytes,6,opt,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_booking_booking_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Resource) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Resource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetMaxDurationMinutes() int32 {
+	if x != nil {
+		return x.MaxDurationMinutes
+	}
+	return 0
+}
+
+func (x *Resource) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Resource) GetRules() *ResourceRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Сообщение для получения списка ресурсов
+type ListResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *string                `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListResourcesRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+type ListResourcesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Resources []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	// Часовой пояс общежития (IANA), в котором действуют правила ресурсов
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesResponse) Reset() {
+	*x = ListResourcesResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesResponse) ProtoMessage() {}
+
+func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListResourcesResponse) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ListResourcesResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// Сообщение для создания записи на ресурс
+type CreateBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ResourceId    int32                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{4}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *CreateBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateBookingResponse) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CreateBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для получения всех записей на ресурс
+type GetBookingsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId int32                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// День по времени общежития в формате YYYY-MM-DD: записи, начинающиеся в этот день
+	Date          *string `protobuf:"bytes,4,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingsRequest) Reset() {
+	*x = GetBookingsRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingsRequest) ProtoMessage() {}
+
+func (x *GetBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBookingsRequest) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *GetBookingsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetBookingsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetBookingsRequest) GetDate() string {
+	if x != nil && x.Date != nil {
+		return *x.Date
+	}
+	return ""
+}
+
+type Booking struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceId int32                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	UserId     int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Серия повторяющихся записей, если запись входит в серию
+	SeriesId      *int32 `protobuf:"varint,6,opt,name=series_id,json=seriesId,proto3,oneof" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_booking_booking_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Booking) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Booking) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *Booking) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Booking) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Booking) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Booking) GetSeriesId() int32 {
+	if x != nil && x.SeriesId != nil {
+		return *x.SeriesId
+	}
+	return 0
+}
+
+type GetBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingsResponse) Reset() {
+	*x = GetBookingsResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingsResponse) ProtoMessage() {}
+
+func (x *GetBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// Сообщение для получения записей пользователя
+type GetUserBookingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token         string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ResourceType  *string `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBookingsRequest) Reset() {
+	*x = GetUserBookingsRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBookingsRequest) ProtoMessage() {}
+
+func (x *GetUserBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *GetUserBookingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetUserBookingsRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+type GetUserBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBookingsResponse) Reset() {
+	*x = GetUserBookingsResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBookingsResponse) ProtoMessage() {}
+
+func (x *GetUserBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBookingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// Сообщение для удаления записи
+type DeleteBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookingRequest) Reset() {
+	*x = DeleteBookingRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookingRequest) ProtoMessage() {}
+
+func (x *DeleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *DeleteBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type DeleteBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookingResponse) Reset() {
+	*x = DeleteBookingResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookingResponse) ProtoMessage() {}
+
+func (x *DeleteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Окно недельного расписания по местному времени общежития.
+// Если end <= start, окно переходит через полночь (например, 23:00-07:00)
+type WeeklyWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// День недели начала окна: 0 - воскресенье, 6 - суббота
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// Время в формате HH:MM
+	Start         string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeeklyWindow) Reset() {
+	*x = WeeklyWindow{}
+	mi := &file_booking_booking_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyWindow) ProtoMessage() {}
+
+func (x *WeeklyWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyWindow.ProtoReflect.Descriptor instead.
+func (*WeeklyWindow) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WeeklyWindow) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WeeklyWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WeeklyWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// Период, когда записаться нельзя
+type ClosedPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosedPeriod) Reset() {
+	*x = ClosedPeriod{}
+	mi := &file_booking_booking_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosedPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosedPeriod) ProtoMessage() {}
+
+func (x *ClosedPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosedPeriod.ProtoReflect.Descriptor instead.
+func (*ClosedPeriod) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ClosedPeriod) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ClosedPeriod) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// Сообщение для получения расписания помещения
+type GetFacilityScheduleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Первый день в формате YYYY-MM-DD, по умолчанию сегодня
+	FromDate *string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"`
+	// Количество дней, по умолчанию 7, не больше 31
+	Days          *int32 `protobuf:"varint,3,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFacilityScheduleRequest) Reset() {
+	*x = GetFacilityScheduleRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFacilityScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFacilityScheduleRequest) ProtoMessage() {}
+
+func (x *GetFacilityScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFacilityScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetFacilityScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetFacilityScheduleRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *GetFacilityScheduleRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *GetFacilityScheduleRequest) GetDays() int32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+type GetFacilityScheduleResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Если часы работы не заданы, помещение открыто круглосуточно
+	OpeningHours []*WeeklyWindow `protobuf:"bytes,2,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	QuietHours   []*WeeklyWindow `protobuf:"bytes,3,rep,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	// Закрытые периоды и тихие часы в запрошенном диапазоне
+	ClosedPeriods []*ClosedPeriod `protobuf:"bytes,4,rep,name=closed_periods,json=closedPeriods,proto3" json:"closed_periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFacilityScheduleResponse) Reset() {
+	*x = GetFacilityScheduleResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFacilityScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFacilityScheduleResponse) ProtoMessage() {}
+
+func (x *GetFacilityScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFacilityScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetFacilityScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetFacilityScheduleResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetFacilityScheduleResponse) GetOpeningHours() []*WeeklyWindow {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *GetFacilityScheduleResponse) GetQuietHours() []*WeeklyWindow {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *GetFacilityScheduleResponse) GetClosedPeriods() []*ClosedPeriod {
+	if x != nil {
+		return x.ClosedPeriods
+	}
+	return nil
+}
+
+// Сообщение для получения лимитов бронирования текущего пользователя
+type GetMyQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ResourceType  string `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyQuotaRequest) Reset() {
+	*x = GetMyQuotaRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyQuotaRequest) ProtoMessage() {}
+
+func (x *GetMyQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetMyQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{17}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *GetMyQuotaRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetMyQuotaRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+// Лимиты и их использование за текущие день и неделю по времени общежития.
+// Лимит и остаток не заполнены, если ограничения нет
+type GetMyQuotaResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType             string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ActiveBookings           int32                  `protobuf:"varint,2,opt,name=active_bookings,json=activeBookings,proto3" json:"active_bookings,omitempty"`
+	MaxActiveBookings        *int32                 `protobuf:"varint,3,opt,name=max_active_bookings,json=maxActiveBookings,proto3,oneof" json:"max_active_bookings,omitempty"`
+	RemainingActiveBookings  *int32                 `protobuf:"varint,4,opt,name=remaining_active_bookings,json=remainingActiveBookings,proto3,oneof" json:"remaining_active_bookings,omitempty"`
+	UsedMinutesToday         int32                  `protobuf:"varint,5,opt,name=used_minutes_today,json=usedMinutesToday,proto3" json:"used_minutes_today,omitempty"`
+	MaxMinutesPerDay         *int32                 `protobuf:"varint,6,opt,name=max_minutes_per_day,json=maxMinutesPerDay,proto3,oneof" json:"max_minutes_per_day,omitempty"`
+	RemainingMinutesToday    *int32                 `protobuf:"varint,7,opt,name=remaining_minutes_today,json=remainingMinutesToday,proto3,oneof" json:"remaining_minutes_today,omitempty"`
+	UsedMinutesThisWeek      int32                  `protobuf:"varint,8,opt,name=used_minutes_this_week,json=usedMinutesThisWeek,proto3" json:"used_minutes_this_week,omitempty"`
+	MaxMinutesPerWeek        *int32                 `protobuf:"varint,9,opt,name=max_minutes_per_week,json=maxMinutesPerWeek,proto3,oneof" json:"max_minutes_per_week,omitempty"`
+	RemainingMinutesThisWeek *int32                 `protobuf:"varint,10,opt,name=remaining_minutes_this_week,json=remainingMinutesThisWeek,proto3,oneof" json:"remaining_minutes_this_week,omitempty"`
+	MinGapMinutes            *int32                 `protobuf:"varint,11,opt,name=min_gap_minutes,json=minGapMinutes,proto3,oneof" json:"min_gap_minutes,omitempty"`
+	MaxAdvanceMinutes        *int32                 `protobuf:"varint,12,opt,name=max_advance_minutes,json=maxAdvanceMinutes,proto3,oneof" json:"max_advance_minutes,omitempty"`
+	// Неявки на записи любых помещений за no_show_window_days дней и их лимит,
+	// после которого бронирование временно запрещается
+	RecentNoShows    int32  `protobuf:"varint,13,opt,name=recent_no_shows,json=recentNoShows,proto3" json:"recent_no_shows,omitempty"`
+	MaxNoShows       *int32 `protobuf:"varint,14,opt,name=max_no_shows,json=maxNoShows,proto3,oneof" json:"max_no_shows,omitempty"`
+	NoShowWindowDays int32  `protobuf:"varint,15,opt,name=no_show_window_days,json=noShowWindowDays,proto3" json:"no_show_window_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMyQuotaResponse) Reset() {
+	*x = GetMyQuotaResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyQuotaResponse) ProtoMessage() {}
+
+func (x *GetMyQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetMyQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetMyQuotaResponse) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *GetMyQuotaResponse) GetActiveBookings() int32 {
+	if x != nil {
+		return x.ActiveBookings
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetMaxActiveBookings() int32 {
+	if x != nil && x.MaxActiveBookings != nil {
+		return *x.MaxActiveBookings
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetRemainingActiveBookings() int32 {
+	if x != nil && x.RemainingActiveBookings != nil {
+		return *x.RemainingActiveBookings
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetUsedMinutesToday() int32 {
+	if x != nil {
+		return x.UsedMinutesToday
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetMaxMinutesPerDay() int32 {
+	if x != nil && x.MaxMinutesPerDay != nil {
+		return *x.MaxMinutesPerDay
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetRemainingMinutesToday() int32 {
+	if x != nil && x.RemainingMinutesToday != nil {
+		return *x.RemainingMinutesToday
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetUsedMinutesThisWeek() int32 {
+	if x != nil {
+		return x.UsedMinutesThisWeek
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetMaxMinutesPerWeek() int32 {
+	if x != nil && x.MaxMinutesPerWeek != nil {
+		return *x.MaxMinutesPerWeek
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetRemainingMinutesThisWeek() int32 {
+	if x != nil && x.RemainingMinutesThisWeek != nil {
+		return *x.RemainingMinutesThisWeek
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetMinGapMinutes() int32 {
+	if x != nil && x.MinGapMinutes != nil {
+		return *x.MinGapMinutes
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetMaxAdvanceMinutes() int32 {
+	if x != nil && x.MaxAdvanceMinutes != nil {
+		return *x.MaxAdvanceMinutes
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetRecentNoShows() int32 {
+	if x != nil {
+		return x.RecentNoShows
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetMaxNoShows() int32 {
+	if x != nil && x.MaxNoShows != nil {
+		return *x.MaxNoShows
+	}
+	return 0
+}
+
+func (x *GetMyQuotaResponse) GetNoShowWindowDays() int32 {
+	if x != nil {
+		return x.NoShowWindowDays
+	}
+	return 0
+}
+
+// Сообщение для создания серии повторяющихся записей.
+// Повторения идут каждую interval_weeks-ю неделю в то же время по часам общежития,
+// начиная с start_time. Нужно задать count и/или until_date
+type CreateBookingSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ResourceId int32  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Первое повторение
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 1 - каждую неделю (по умолчанию), 2 - раз в две недели
+	IntervalWeeks int32 `protobuf:"varint,5,opt,name=interval_weeks,json=intervalWeeks,proto3" json:"interval_weeks,omitempty"`
+	// Количество повторений
+	Count *int32 `protobuf:"varint,6,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// Последний день серии в формате YYYY-MM-DD по времени общежития (включительно)
+	UntilDate     *string `protobuf:"bytes,7,opt,name=until_date,json=untilDate,proto3,oneof" json:"until_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingSeriesRequest) Reset() {
+	*x = CreateBookingSeriesRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingSeriesRequest) ProtoMessage() {}
+
+func (x *CreateBookingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{19}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *CreateBookingSeriesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateBookingSeriesRequest) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *CreateBookingSeriesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateBookingSeriesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateBookingSeriesRequest) GetIntervalWeeks() int32 {
+	if x != nil {
+		return x.IntervalWeeks
+	}
+	return 0
+}
+
+func (x *CreateBookingSeriesRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *CreateBookingSeriesRequest) GetUntilDate() string {
+	if x != nil && x.UntilDate != nil {
+		return *x.UntilDate
+	}
+	return ""
+}
+
+// Результат записи на одно повторение серии
+type SeriesOccurrence struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Не заполнено, если записаться не удалось
+	BookingId *int32 `protobuf:"varint,3,opt,name=booking_id,json=bookingId,proto3,oneof" json:"booking_id,omitempty"`
+	// Причина отказа (например, TIME_SLOT_BOOKED) и ее описание
+	ErrorReason   string `protobuf:"bytes,4,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesOccurrence) Reset() {
+	*x = SeriesOccurrence{}
+	mi := &file_booking_booking_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesOccurrence) ProtoMessage() {}
+
+func (x *SeriesOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesOccurrence.ProtoReflect.Descriptor instead.
+func (*SeriesOccurrence) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SeriesOccurrence) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SeriesOccurrence) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SeriesOccurrence) GetBookingId() int32 {
+	if x != nil && x.BookingId != nil {
+		return *x.BookingId
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+func (x *SeriesOccurrence) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type CreateBookingSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      int32                  `protobuf:"varint,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Occurrences   []*SeriesOccurrence    `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingSeriesResponse) Reset() {
+	*x = CreateBookingSeriesResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingSeriesResponse) ProtoMessage() {}
+
+func (x *CreateBookingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateBookingSeriesResponse) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *CreateBookingSeriesResponse) GetOccurrences() []*SeriesOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *CreateBookingSeriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BookingSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceId    int32                  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IntervalWeeks int32                  `protobuf:"varint,5,opt,name=interval_weeks,json=intervalWeeks,proto3" json:"interval_weeks,omitempty"`
+	Count         *int32                 `protobuf:"varint,6,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// Повторения, начинающиеся в этот момент или позже, не входят в серию
+	Until *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// Оставшиеся записи серии
+	Bookings      []*Booking             `protobuf:"bytes,8,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingSeries) Reset() {
+	*x = BookingSeries{}
+	mi := &file_booking_booking_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingSeries) ProtoMessage() {}
+
+func (x *BookingSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSeries.ProtoReflect.Descriptor instead.
+func (*BookingSeries) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BookingSeries) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookingSeries) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *BookingSeries) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BookingSeries) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *BookingSeries) GetIntervalWeeks() int32 {
+	if x != nil {
+		return x.IntervalWeeks
+	}
+	return 0
+}
+
+func (x *BookingSeries) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *BookingSeries) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *BookingSeries) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *BookingSeries) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Сообщение для получения серий текущего пользователя
+type GetMyBookingSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyBookingSeriesRequest) Reset() {
+	*x = GetMyBookingSeriesRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyBookingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBookingSeriesRequest) ProtoMessage() {}
+
+func (x *GetMyBookingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBookingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetMyBookingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{23}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *GetMyBookingSeriesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetMyBookingSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*BookingSeries       `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyBookingSeriesResponse) Reset() {
+	*x = GetMyBookingSeriesResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyBookingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBookingSeriesResponse) ProtoMessage() {}
+
+func (x *GetMyBookingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBookingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetMyBookingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMyBookingSeriesResponse) GetSeries() []*BookingSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// Сообщение для отмены записей серии
+type CancelBookingSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in booking/booking_service.proto.
+	Token    string            `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SeriesId int32             `protobuf:"varint,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Scope    SeriesCancelScope `protobuf:"varint,3,opt,name=scope,proto3,enum=booking.SeriesCancelScope" json:"scope,omitempty"`
+	// Обязательно для SERIES_CANCEL_SCOPE_OCCURRENCE и SERIES_CANCEL_SCOPE_FOLLOWING
+	BookingId     *int32 `protobuf:"varint,4,opt,name=booking_id,json=bookingId,proto3,oneof" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingSeriesRequest) Reset() {
+	*x = CancelBookingSeriesRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingSeriesRequest) ProtoMessage() {}
+
+func (x *CancelBookingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{25}
+}
+
+// Deprecated: Marked as deprecated in booking/booking_service.proto.
+func (x *CancelBookingSeriesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CancelBookingSeriesRequest) GetSeriesId() int32 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *CancelBookingSeriesRequest) GetScope() SeriesCancelScope {
+	if x != nil {
+		return x.Scope
+	}
+	return SeriesCancelScope_SERIES_CANCEL_SCOPE_UNSPECIFIED
+}
+
+func (x *CancelBookingSeriesRequest) GetBookingId() int32 {
+	if x != nil && x.BookingId != nil {
+		return *x.BookingId
+	}
+	return 0
+}
+
+type CancelBookingSeriesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CancelledBookings int32                  `protobuf:"varint,1,opt,name=cancelled_bookings,json=cancelledBookings,proto3" json:"cancelled_bookings,omitempty"`
+	Message           string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelBookingSeriesResponse) Reset() {
+	*x = CancelBookingSeriesResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingSeriesResponse) ProtoMessage() {}
+
+func (x *CancelBookingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelBookingSeriesResponse) GetCancelledBookings() int32 {
+	if x != nil {
+		return x.CancelledBookings
+	}
+	return 0
+}
+
+func (x *CancelBookingSeriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для поиска свободных слотов помещения. Слоты начинаются на сетке шага
+// слота ресурса и не пересекают записи, закрытия, тихие часы и время вне часов работы
+type FindAvailableSlotsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Искать только на этом ресурсе помещения
+	ResourceId *int32 `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// Первый и последний день поиска включительно (YYYY-MM-DD) по времени общежития.
+	// По умолчанию сегодня, to_date - from_date. Не больше 14 дней
+	FromDate        *string `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"`
+	ToDate          *string `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date,omitempty"`
+	DurationMinutes int32   `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Сколько слотов вернуть, по умолчанию 50, не больше 200
+	Limit         *int32 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableSlotsRequest) Reset() {
+	*x = FindAvailableSlotsRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableSlotsRequest) ProtoMessage() {}
+
+func (x *FindAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*FindAvailableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FindAvailableSlotsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *FindAvailableSlotsRequest) GetResourceId() int32 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *FindAvailableSlotsRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *FindAvailableSlotsRequest) GetToDate() string {
+	if x != nil && x.ToDate != nil {
+		return *x.ToDate
+	}
+	return ""
+}
+
+func (x *FindAvailableSlotsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *FindAvailableSlotsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type AvailableSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int32                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailableSlot) Reset() {
+	*x = AvailableSlot{}
+	mi := &file_booking_booking_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableSlot) ProtoMessage() {}
+
+func (x *AvailableSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableSlot.ProtoReflect.Descriptor instead.
+func (*AvailableSlot) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AvailableSlot) GetResourceId() int32 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *AvailableSlot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AvailableSlot) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type FindAvailableSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*AvailableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableSlotsResponse) Reset() {
+	*x = FindAvailableSlotsResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableSlotsResponse) ProtoMessage() {}
+
+func (x *FindAvailableSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableSlotsResponse.ProtoReflect.Descriptor instead.
+func (*FindAvailableSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FindAvailableSlotsResponse) GetSlots() []*AvailableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *FindAvailableSlotsResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// Сообщение для поиска ближайшего свободного слота начиная с текущего момента
+type FindNextAvailableSlotRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType    string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId      *int32                 `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindNextAvailableSlotRequest) Reset() {
+	*x = FindNextAvailableSlotRequest{}
+	mi := &file_booking_booking_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextAvailableSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextAvailableSlotRequest) ProtoMessage() {}
+
+func (x *FindNextAvailableSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextAvailableSlotRequest.ProtoReflect.Descriptor instead.
+func (*FindNextAvailableSlotRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FindNextAvailableSlotRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *FindNextAvailableSlotRequest) GetResourceId() int32 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *FindNextAvailableSlotRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type FindNextAvailableSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *AvailableSlot         `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNextAvailableSlotResponse) Reset() {
+	*x = FindNextAvailableSlotResponse{}
+	mi := &file_booking_booking_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextAvailableSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextAvailableSlotResponse) ProtoMessage() {}
+
+func (x *FindNextAvailableSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextAvailableSlotResponse.ProtoReflect.Descriptor instead.
+func (*FindNextAvailableSlotResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FindNextAvailableSlotResponse) GetSlot() *AvailableSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+var File_booking_booking_service_proto protoreflect.FileDescriptor
+
+const file_booking_booking_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dbooking/booking_service.proto\x12\abooking\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa2\x01\n" +
+	"\rResourceRules\x120\n" +
+	"\x14min_duration_minutes\x18\x01 \x01(\x05R\x12minDurationMinutes\x12*\n" +
+	"\x11slot_step_minutes\x18\x02 \x01(\x05R\x0fslotStepMinutes\x123\n" +
+	"\x16check_in_grace_minutes\x18\x03 \x01(\x05R\x13checkInGraceMinutes\"\xbe\x01\n" +
+	"\bResource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
+	"\x14max_duration_minutes\x18\x04 \x01(\x05R\x12maxDurationMinutes\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12,\n" +
+	"\x05rules\x18\x06 \x01(\v2\x16.booking.ResourceRulesR\x05rules\"8\n" +
+	"\x14ListResourcesRequest\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01B\a\n" +
+	"\x05_type\"e\n" +
+	"\x15ListResourcesResponse\x12/\n" +
+	"\tresources\x18\x01 \x03(\v2\x11.booking.ResourceR\tresources\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"\xc3\x01\n" +
+	"\x14CreateBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x05R\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"P\n" +
+	"\x15CreateBookingResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xef\x01\n" +
+	"\x12GetBookingsRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x05R\n" +
+	"resourceId\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\x17\n" +
+	"\x04date\x18\x04 \x01(\tH\x02R\x04date\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\a\n" +
+	"\x05_date\"\xf5\x01\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x05R\n" +
+	"resourceId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\tseries_id\x18\x06 \x01(\x05H\x00R\bseriesId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_series_id\"C\n" +
+	"\x13GetBookingsResponse\x12,\n" +
+	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\"n\n" +
+	"\x16GetUserBookingsRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12(\n" +
+	"\rresource_type\x18\x02 \x01(\tH\x00R\fresourceType\x88\x01\x01B\x10\n" +
+	"\x0e_resource_type\"G\n" +
+	"\x17GetUserBookingsResponse\x12,\n" +
+	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\"O\n" +
+	"\x14DeleteBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\"1\n" +
+	"\x15DeleteBookingResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"P\n" +
+	"\fWeeklyWindow\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"\x80\x01\n" +
+	"\fClosedPeriod\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"\x93\x01\n" +
+	"\x1aGetFacilityScheduleRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12 \n" +
+	"\tfrom_date\x18\x02 \x01(\tH\x00R\bfromDate\x88\x01\x01\x12\x17\n" +
+	"\x04days\x18\x03 \x01(\x05H\x01R\x04days\x88\x01\x01B\f\n" +
+	"\n" +
+	"_from_dateB\a\n" +
+	"\x05_days\"\xec\x01\n" +
+	"\x1bGetFacilityScheduleResponse\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\x12:\n" +
+	"\ropening_hours\x18\x02 \x03(\v2\x15.booking.WeeklyWindowR\fopeningHours\x126\n" +
+	"\vquiet_hours\x18\x03 \x03(\v2\x15.booking.WeeklyWindowR\n" +
+	"quietHours\x12<\n" +
+	"\x0eclosed_periods\x18\x04 \x03(\v2\x15.booking.ClosedPeriodR\rclosedPeriods\"R\n" +
+	"\x11GetMyQuotaRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\"\xe6\a\n" +
+	"\x12GetMyQuotaResponse\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12'\n" +
+	"\x0factive_bookings\x18\x02 \x01(\x05R\x0eactiveBookings\x123\n" +
+	"\x13max_active_bookings\x18\x03 \x01(\x05H\x00R\x11maxActiveBookings\x88\x01\x01\x12?\n" +
+	"\x19remaining_active_bookings\x18\x04 \x01(\x05H\x01R\x17remainingActiveBookings\x88\x01\x01\x12,\n" +
+	"\x12used_minutes_today\x18\x05 \x01(\x05R\x10usedMinutesToday\x122\n" +
+	"\x13max_minutes_per_day\x18\x06 \x01(\x05H\x02R\x10maxMinutesPerDay\x88\x01\x01\x12;\n" +
+	"\x17remaining_minutes_today\x18\a \x01(\x05H\x03R\x15remainingMinutesToday\x88\x01\x01\x123\n" +
+	"\x16used_minutes_this_week\x18\b \x01(\x05R\x13usedMinutesThisWeek\x124\n" +
+	"\x14max_minutes_per_week\x18\t \x01(\x05H\x04R\x11maxMinutesPerWeek\x88\x01\x01\x12B\n" +
+	"\x1bremaining_minutes_this_week\x18\n" +
+	" \x01(\x05H\x05R\x18remainingMinutesThisWeek\x88\x01\x01\x12+\n" +
+	"\x0fmin_gap_minutes\x18\v \x01(\x05H\x06R\rminGapMinutes\x88\x01\x01\x123\n" +
+	"\x13max_advance_minutes\x18\f \x01(\x05H\aR\x11maxAdvanceMinutes\x88\x01\x01\x12&\n" +
+	"\x0frecent_no_shows\x18\r \x01(\x05R\rrecentNoShows\x12%\n" +
+	"\fmax_no_shows\x18\x0e \x01(\x05H\bR\n" +
+	"maxNoShows\x88\x01\x01\x12-\n" +
+	"\x13no_show_window_days\x18\x0f \x01(\x05R\x10noShowWindowDaysB\x16\n" +
+	"\x14_max_active_bookingsB\x1c\n" +
+	"\x1a_remaining_active_bookingsB\x16\n" +
+	"\x14_max_minutes_per_dayB\x1a\n" +
+	"\x18_remaining_minutes_todayB\x17\n" +
+	"\x15_max_minutes_per_weekB\x1e\n" +
+	"\x1c_remaining_minutes_this_weekB\x12\n" +
+	"\x10_min_gap_minutesB\x16\n" +
+	"\x14_max_advance_minutesB\x0f\n" +
+	"\r_max_no_shows\"\xc8\x02\n" +
+	"\x1aCreateBookingSeriesRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x05R\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12%\n" +
+	"\x0einterval_weeks\x18\x05 \x01(\x05R\rintervalWeeks\x12\x19\n" +
+	"\x05count\x18\x06 \x01(\x05H\x00R\x05count\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"until_date\x18\a \x01(\tH\x01R\tuntilDate\x88\x01\x01B\b\n" +
+	"\x06_countB\r\n" +
+	"\v_until_date\"\xff\x01\n" +
+	"\x10SeriesOccurrence\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\"\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\x05H\x00R\tbookingId\x88\x01\x01\x12!\n" +
+	"\ferror_reason\x18\x04 \x01(\tR\verrorReason\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessageB\r\n" +
+	"\v_booking_id\"\x91\x01\n" +
+	"\x1bCreateBookingSeriesResponse\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\x05R\bseriesId\x12;\n" +
+	"\voccurrences\x18\x02 \x03(\v2\x19.booking.SeriesOccurrenceR\voccurrences\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa8\x03\n" +
+	"\rBookingSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x05R\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12%\n" +
+	"\x0einterval_weeks\x18\x05 \x01(\x05R\rintervalWeeks\x12\x19\n" +
+	"\x05count\x18\x06 \x01(\x05H\x00R\x05count\x88\x01\x01\x125\n" +
+	"\x05until\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05until\x88\x01\x01\x12,\n" +
+	"\bbookings\x18\b \x03(\v2\x10.booking.BookingR\bbookings\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\b\n" +
+	"\x06_countB\b\n" +
+	"\x06_until\"5\n" +
+	"\x19GetMyBookingSeriesRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\"L\n" +
+	"\x1aGetMyBookingSeriesResponse\x12.\n" +
+	"\x06series\x18\x01 \x03(\v2\x16.booking.BookingSeriesR\x06series\"\xb8\x01\n" +
+	"\x1aCancelBookingSeriesRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\x05R\bseriesId\x120\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x1a.booking.SeriesCancelScopeR\x05scope\x12\"\n" +
+	"\n" +
+	"booking_id\x18\x04 \x01(\x05H\x00R\tbookingId\x88\x01\x01B\r\n" +
+	"\v_booking_id\"f\n" +
+	"\x1bCancelBookingSeriesResponse\x12-\n" +
+	"\x12cancelled_bookings\x18\x01 \x01(\x05R\x11cancelledBookings\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa0\x02\n" +
+	"\x19FindAvailableSlotsRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12$\n" +
+	"\vresource_id\x18\x02 \x01(\x05H\x00R\n" +
+	"resourceId\x88\x01\x01\x12 \n" +
+	"\tfrom_date\x18\x03 \x01(\tH\x01R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x04 \x01(\tH\x02R\x06toDate\x88\x01\x01\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\x12\x19\n" +
+	"\x05limit\x18\x06 \x01(\x05H\x03R\x05limit\x88\x01\x01B\x0e\n" +
+	"\f_resource_idB\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_dateB\b\n" +
+	"\x06_limit\"\xa2\x01\n" +
+	"\rAvailableSlot\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x05R\n" +
+	"resourceId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"g\n" +
+	"\x1aFindAvailableSlotsResponse\x12,\n" +
+	"\x05slots\x18\x01 \x03(\v2\x16.booking.AvailableSlotR\x05slots\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"\xa4\x01\n" +
+	"\x1cFindNextAvailableSlotRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12$\n" +
+	"\vresource_id\x18\x02 \x01(\x05H\x00R\n" +
+	"resourceId\x88\x01\x01\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutesB\x0e\n" +
+	"\f_resource_id\"K\n" +
+	"\x1dFindNextAvailableSlotResponse\x12*\n" +
+	"\x04slot\x18\x01 \x01(\v2\x16.booking.AvailableSlotR\x04slot*\x9c\x01\n" +
+	"\x11SeriesCancelScope\x12#\n" +
+	"\x1fSERIES_CANCEL_SCOPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eSERIES_CANCEL_SCOPE_OCCURRENCE\x10\x01\x12!\n" +
+	"\x1dSERIES_CANCEL_SCOPE_FOLLOWING\x10\x02\x12\x1b\n" +
+	"\x17SERIES_CANCEL_SCOPE_ALL\x10\x032\xc1\f\n" +
+	"\x0eBookingService\x12i\n" +
+	"\rListResources\x12\x1d.booking.ListResourcesRequest\x1a\x1e.booking.ListResourcesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/resources\x12\x95\x01\n" +
+	"\x13GetFacilitySchedule\x12#.booking.GetFacilityScheduleRequest\x1a$.booking.GetFacilityScheduleResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/facilities/{resource_type}/schedule\x12\x83\x01\n" +
+	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x1e.booking.CreateBookingResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/resources/{resource_id}/bookings\x12z\n" +
+	"\vGetBookings\x12\x1b.booking.GetBookingsRequest\x1a\x1c.booking.GetBookingsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/resources/{resource_id}/bookings\x12q\n" +
+	"\x0fGetUserBookings\x12\x1f.booking.GetUserBookingsRequest\x1a .booking.GetUserBookingsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/bookings/my\x12\x8f\x01\n" +
+	"\x12FindAvailableSlots\x12\".booking.FindAvailableSlotsRequest\x1a#.booking.FindAvailableSlotsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/facilities/{resource_type}/slots\x12\x9d\x01\n" +
+	"\x15FindNextAvailableSlot\x12%.booking.FindNextAvailableSlotRequest\x1a&.booking.FindNextAvailableSlotResponse\"5\x82\xd3\xe4\x93\x02/\x12-/api/v1/facilities/{resource_type}/slots/next\x12w\n" +
+	"\n" +
+	"GetMyQuota\x12\x1a.booking.GetMyQuotaRequest\x1a\x1b.booking.GetMyQuotaResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/facilities/{resource_type}/quota\x12u\n" +
+	"\rDeleteBooking\x12\x1d.booking.DeleteBookingRequest\x1a\x1e.booking.DeleteBookingResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/bookings/{booking_id}\x12\x93\x01\n" +
+	"\x13CreateBookingSeries\x12#.booking.CreateBookingSeriesRequest\x1a$.booking.CreateBookingSeriesResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/resources/{resource_id}/series\x12x\n" +
+	"\x12GetMyBookingSeries\x12\".booking.GetMyBookingSeriesRequest\x1a#.booking.GetMyBookingSeriesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/series/my\x12\x84\x01\n" +
+	"\x13CancelBookingSeries\x12#.booking.CancelBookingSeriesRequest\x1a$.booking.CancelBookingSeriesResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/series/{series_id}B:Z8dormitory-helper-service/generated/proto/booking;bookingb\x06proto3"
+
+var (
+	file_booking_booking_service_proto_rawDescOnce sync.Once
+	file_booking_booking_service_proto_rawDescData []byte
+)
+
+func file_booking_booking_service_proto_rawDescGZIP() []byte {
+	file_booking_booking_service_proto_rawDescOnce.Do(func() {
+		file_booking_booking_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_booking_service_proto_rawDesc), len(file_booking_booking_service_proto_rawDesc)))
+	})
+	return file_booking_booking_service_proto_rawDescData
+}
+
+var file_booking_booking_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_booking_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_booking_booking_service_proto_goTypes = []any{
+	(SeriesCancelScope)(0),                // 0: booking.SeriesCancelScope
+	(*ResourceRules)(nil),                 // 1: booking.ResourceRules
+	(*Resource)(nil),                      // 2: booking.Resource
+	(*ListResourcesRequest)(nil),          // 3: booking.ListResourcesRequest
+	(*ListResourcesResponse)(nil),         // 4: booking.ListResourcesResponse
+	(*CreateBookingRequest)(nil),          // 5: booking.CreateBookingRequest
+	(*CreateBookingResponse)(nil),         // 6: booking.CreateBookingResponse
+	(*GetBookingsRequest)(nil),            // 7: booking.GetBookingsRequest
+	(*Booking)(nil),                       // 8: booking.Booking
+	(*GetBookingsResponse)(nil),           // 9: booking.GetBookingsResponse
+	(*GetUserBookingsRequest)(nil),        // 10: booking.GetUserBookingsRequest
+	(*GetUserBookingsResponse)(nil),       // 11: booking.GetUserBookingsResponse
+	(*DeleteBookingRequest)(nil),          // 12: booking.DeleteBookingRequest
+	(*DeleteBookingResponse)(nil),         // 13: booking.DeleteBookingResponse
+	(*WeeklyWindow)(nil),                  // 14: booking.WeeklyWindow
+	(*ClosedPeriod)(nil),                  // 15: booking.ClosedPeriod
+	(*GetFacilityScheduleRequest)(nil),    // 16: booking.GetFacilityScheduleRequest
+	(*GetFacilityScheduleResponse)(nil),   // 17: booking.GetFacilityScheduleResponse
+	(*GetMyQuotaRequest)(nil),             // 18: booking.GetMyQuotaRequest
+	(*GetMyQuotaResponse)(nil),            // 19: booking.GetMyQuotaResponse
+	(*CreateBookingSeriesRequest)(nil),    // 20: booking.CreateBookingSeriesRequest
+	(*SeriesOccurrence)(nil),              // 21: booking.SeriesOccurrence
+	(*CreateBookingSeriesResponse)(nil),   // 22: booking.CreateBookingSeriesResponse
+	(*BookingSeries)(nil),                 // 23: booking.BookingSeries
+	(*GetMyBookingSeriesRequest)(nil),     // 24: booking.GetMyBookingSeriesRequest
+	(*GetMyBookingSeriesResponse)(nil),    // 25: booking.GetMyBookingSeriesResponse
+	(*CancelBookingSeriesRequest)(nil),    // 26: booking.CancelBookingSeriesRequest
+	(*CancelBookingSeriesResponse)(nil),   // 27: booking.CancelBookingSeriesResponse
+	(*FindAvailableSlotsRequest)(nil),     // 28: booking.FindAvailableSlotsRequest
+	(*AvailableSlot)(nil),                 // 29: booking.AvailableSlot
+	(*FindAvailableSlotsResponse)(nil),    // 30: booking.FindAvailableSlotsResponse
+	(*FindNextAvailableSlotRequest)(nil),  // 31: booking.FindNextAvailableSlotRequest
+	(*FindNextAvailableSlotResponse)(nil), // 32: booking.FindNextAvailableSlotResponse
+	(*timestamppb.Timestamp)(nil),         // 33: google.protobuf.Timestamp
+}
+var file_booking_booking_service_proto_depIdxs = []int32{
+	1,  // 0: booking.Resource.rules:type_name -> booking.ResourceRules
+	2,  // 1: booking.ListResourcesResponse.resources:type_name -> booking.Resource
+	33, // 2: booking.CreateBookingRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 3: booking.CreateBookingRequest.end_time:type_name -> google.protobuf.Timestamp
+	33, // 4: booking.GetBookingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 5: booking.GetBookingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	33, // 6: booking.Booking.start_time:type_name -> google.protobuf.Timestamp
+	33, // 7: booking.Booking.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 8: booking.GetBookingsResponse.bookings:type_name -> booking.Booking
+	8,  // 9: booking.GetUserBookingsResponse.bookings:type_name -> booking.Booking
+	33, // 10: booking.ClosedPeriod.start_time:type_name -> google.protobuf.Timestamp
+	33, // 11: booking.ClosedPeriod.end_time:type_name -> google.protobuf.Timestamp
+	14, // 12: booking.GetFacilityScheduleResponse.opening_hours:type_name -> booking.WeeklyWindow
+	14, // 13: booking.GetFacilityScheduleResponse.quiet_hours:type_name -> booking.WeeklyWindow
+	15, // 14: booking.GetFacilityScheduleResponse.closed_periods:type_name -> booking.ClosedPeriod
+	33, // 15: booking.CreateBookingSeriesRequest.start_time:type_name -> google.protobuf.Timestamp
+	33, // 16: booking.CreateBookingSeriesRequest.end_time:type_name -> google.protobuf.Timestamp
+	33, // 17: booking.SeriesOccurrence.start_time:type_name -> google.protobuf.Timestamp
+	33, // 18: booking.SeriesOccurrence.end_time:type_name -> google.protobuf.Timestamp
+	21, // 19: booking.CreateBookingSeriesResponse.occurrences:type_name -> booking.SeriesOccurrence
+	33, // 20: booking.BookingSeries.start_time:type_name -> google.protobuf.Timestamp
+	33, // 21: booking.BookingSeries.end_time:type_name -> google.protobuf.Timestamp
+	33, // 22: booking.BookingSeries.until:type_name -> google.protobuf.Timestamp
+	8,  // 23: booking.BookingSeries.bookings:type_name -> booking.Booking
+	33, // 24: booking.BookingSeries.created_at:type_name -> google.protobuf.Timestamp
+	23, // 25: booking.GetMyBookingSeriesResponse.series:type_name -> booking.BookingSeries
+	0,  // 26: booking.CancelBookingSeriesRequest.scope:type_name -> booking.SeriesCancelScope
+	33, // 27: booking.AvailableSlot.start_time:type_name -> google.protobuf.Timestamp
+	33, // 28: booking.AvailableSlot.end_time:type_name -> google.protobuf.Timestamp
+	29, // 29: booking.FindAvailableSlotsResponse.slots:type_name -> booking.AvailableSlot
+	29, // 30: booking.FindNextAvailableSlotResponse.slot:type_name -> booking.AvailableSlot
+	3,  // 31: booking.BookingService.ListResources:input_type -> booking.ListResourcesRequest
+	16, // 32: booking.BookingService.GetFacilitySchedule:input_type -> booking.GetFacilityScheduleRequest
+	5,  // 33: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
+	7,  // 34: booking.BookingService.GetBookings:input_type -> booking.GetBookingsRequest
+	10, // 35: booking.BookingService.GetUserBookings:input_type -> booking.GetUserBookingsRequest
+	28, // 36: booking.BookingService.FindAvailableSlots:input_type -> booking.FindAvailableSlotsRequest
+	31, // 37: booking.BookingService.FindNextAvailableSlot:input_type -> booking.FindNextAvailableSlotRequest
+	18, // 38: booking.BookingService.GetMyQuota:input_type -> booking.GetMyQuotaRequest
+	12, // 39: booking.BookingService.DeleteBooking:input_type -> booking.DeleteBookingRequest
+	20, // 40: booking.BookingService.CreateBookingSeries:input_type -> booking.CreateBookingSeriesRequest
+	24, // 41: booking.BookingService.GetMyBookingSeries:input_type -> booking.GetMyBookingSeriesRequest
+	26, // 42: booking.BookingService.CancelBookingSeries:input_type -> booking.CancelBookingSeriesRequest
+	4,  // 43: booking.BookingService.ListResources:output_type -> booking.ListResourcesResponse
+	17, // 44: booking.BookingService.GetFacilitySchedule:output_type -> booking.GetFacilityScheduleResponse
+	6,  // 45: booking.BookingService.CreateBooking:output_type -> booking.CreateBookingResponse
+	9,  // 46: booking.BookingService.GetBookings:output_type -> booking.GetBookingsResponse
+	11, // 47: booking.BookingService.GetUserBookings:output_type -> booking.GetUserBookingsResponse
+	30, // 48: booking.BookingService.FindAvailableSlots:output_type -> booking.FindAvailableSlotsResponse
+	32, // 49: booking.BookingService.FindNextAvailableSlot:output_type -> booking.FindNextAvailableSlotResponse
+	19, // 50: booking.BookingService.GetMyQuota:output_type -> booking.GetMyQuotaResponse
+	13, // 51: booking.BookingService.DeleteBooking:output_type -> booking.DeleteBookingResponse
+	22, // 52: booking.BookingService.CreateBookingSeries:output_type -> booking.CreateBookingSeriesResponse
+	25, // 53: booking.BookingService.GetMyBookingSeries:output_type -> booking.GetMyBookingSeriesResponse
+	27, // 54: booking.BookingService.CancelBookingSeries:output_type -> booking.CancelBookingSeriesResponse
+	43, // [43:55] is the sub-list for method output_type
+	31, // [31:43] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_booking_booking_service_proto_init() }
+func file_booking_booking_service_proto_init() {
+	if File_booking_booking_service_proto != nil {
+		return
+	}
+	file_booking_booking_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[6].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_booking_booking_service_proto_msgTypes[30].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_booking_service_proto_rawDesc), len(file_booking_booking_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_booking_booking_service_proto_goTypes,
+		DependencyIndexes: file_booking_booking_service_proto_depIdxs,
+		EnumInfos:         file_booking_booking_service_proto_enumTypes,
+		MessageInfos:      file_booking_booking_service_proto_msgTypes,
+	}.Build()
+	File_booking_booking_service_proto = out.File
+	file_booking_booking_service_proto_goTypes = nil
+	file_booking_booking_service_proto_depIdxs = nil
+}
@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: booking/booking_service.proto
+
+package booking
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BookingService_ListResources_FullMethodName         = "/booking.BookingService/ListResources"
+	BookingService_GetFacilitySchedule_FullMethodName   = "/booking.BookingService/GetFacilitySchedule"
+	BookingService_CreateBooking_FullMethodName         = "/booking.BookingService/CreateBooking"
+	BookingService_GetBookings_FullMethodName           = "/booking.BookingService/GetBookings"
+	BookingService_GetUserBookings_FullMethodName       = "/booking.BookingService/GetUserBookings"
+	BookingService_FindAvailableSlots_FullMethodName    = "/booking.BookingService/FindAvailableSlots"
+	BookingService_FindNextAvailableSlot_FullMethodName = "/booking.BookingService/FindNextAvailableSlot"
+	BookingService_GetMyQuota_FullMethodName            = "/booking.BookingService/GetMyQuota"
+	BookingService_DeleteBooking_FullMethodName         = "/booking.BookingService/DeleteBooking"
+	BookingService_CreateBookingSeries_FullMethodName   = "/booking.BookingService/CreateBookingSeries"
+	BookingService_GetMyBookingSeries_FullMethodName    = "/booking.BookingService/GetMyBookingSeries"
+	BookingService_CancelBookingSeries_FullMethodName   = "/booking.BookingService/CancelBookingSeries"
+)
+
+// BookingServiceClient is the client API for BookingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookingServiceClient interface {
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	GetFacilitySchedule(ctx context.Context, in *GetFacilityScheduleRequest, opts ...grpc.CallOption) (*GetFacilityScheduleResponse, error)
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
+	GetBookings(ctx context.Context, in *GetBookingsRequest, opts ...grpc.CallOption) (*GetBookingsResponse, error)
+	GetUserBookings(ctx context.Context, in *GetUserBookingsRequest, opts ...grpc.CallOption) (*GetUserBookingsResponse, error)
+	FindAvailableSlots(ctx context.Context, in *FindAvailableSlotsRequest, opts ...grpc.CallOption) (*FindAvailableSlotsResponse, error)
+	FindNextAvailableSlot(ctx context.Context, in *FindNextAvailableSlotRequest, opts ...grpc.CallOption) (*FindNextAvailableSlotResponse, error)
+	GetMyQuota(ctx context.Context, in *GetMyQuotaRequest, opts ...grpc.CallOption) (*GetMyQuotaResponse, error)
+	DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error)
+	CreateBookingSeries(ctx context.Context, in *CreateBookingSeriesRequest, opts ...grpc.CallOption) (*CreateBookingSeriesResponse, error)
+	GetMyBookingSeries(ctx context.Context, in *GetMyBookingSeriesRequest, opts ...grpc.CallOption) (*GetMyBookingSeriesResponse, error)
+	CancelBookingSeries(ctx context.Context, in *CancelBookingSeriesRequest, opts ...grpc.CallOption) (*CancelBookingSeriesResponse, error)
+}
+
+type bookingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingServiceClient(cc grpc.ClientConnInterface) BookingServiceClient {
+	return &bookingServiceClient{cc}
+}
+
+func (c *bookingServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetFacilitySchedule(ctx context.Context, in *GetFacilityScheduleRequest, opts ...grpc.CallOption) (*GetFacilityScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFacilityScheduleResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetFacilitySchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookings(ctx context.Context, in *GetBookingsRequest, opts ...grpc.CallOption) (*GetBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetUserBookings(ctx context.Context, in *GetUserBookingsRequest, opts ...grpc.CallOption) (*GetUserBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetUserBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) FindAvailableSlots(ctx context.Context, in *FindAvailableSlotsRequest, opts ...grpc.CallOption) (*FindAvailableSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindAvailableSlotsResponse)
+	err := c.cc.Invoke(ctx, BookingService_FindAvailableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) FindNextAvailableSlot(ctx context.Context, in *FindNextAvailableSlotRequest, opts ...grpc.CallOption) (*FindNextAvailableSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNextAvailableSlotResponse)
+	err := c.cc.Invoke(ctx, BookingService_FindNextAvailableSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetMyQuota(ctx context.Context, in *GetMyQuotaRequest, opts ...grpc.CallOption) (*GetMyQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyQuotaResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetMyQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateBookingSeries(ctx context.Context, in *CreateBookingSeriesRequest, opts ...grpc.CallOption) (*CreateBookingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookingSeriesResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateBookingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetMyBookingSeries(ctx context.Context, in *GetMyBookingSeriesRequest, opts ...grpc.CallOption) (*GetMyBookingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyBookingSeriesResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetMyBookingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBookingSeries(ctx context.Context, in *CancelBookingSeriesRequest, opts ...grpc.CallOption) (*CancelBookingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingSeriesResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBookingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServiceServer is the server API for BookingService service.
+// All implementations must embed UnimplementedBookingServiceServer
+// for forward compatibility.
+type BookingServiceServer interface {
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	GetFacilitySchedule(context.Context, *GetFacilityScheduleRequest) (*GetFacilityScheduleResponse, error)
+	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
+	GetBookings(context.Context, *GetBookingsRequest) (*GetBookingsResponse, error)
+	GetUserBookings(context.Context, *GetUserBookingsRequest) (*GetUserBookingsResponse, error)
+	FindAvailableSlots(context.Context, *FindAvailableSlotsRequest) (*FindAvailableSlotsResponse, error)
+	FindNextAvailableSlot(context.Context, *FindNextAvailableSlotRequest) (*FindNextAvailableSlotResponse, error)
+	GetMyQuota(context.Context, *GetMyQuotaRequest) (*GetMyQuotaResponse, error)
+	DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error)
+	CreateBookingSeries(context.Context, *CreateBookingSeriesRequest) (*CreateBookingSeriesResponse, error)
+	GetMyBookingSeries(context.Context, *GetMyBookingSeriesRequest) (*GetMyBookingSeriesResponse, error)
+	CancelBookingSeries(context.Context, *CancelBookingSeriesRequest) (*CancelBookingSeriesResponse, error)
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+// UnimplementedBookingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingServiceServer struct{}
+
+func (UnimplementedBookingServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedBookingServiceServer) GetFacilitySchedule(context.Context, *GetFacilityScheduleRequest) (*GetFacilityScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFacilitySchedule not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookings(context.Context, *GetBookingsRequest) (*GetBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetUserBookings(context.Context, *GetUserBookingsRequest) (*GetUserBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) FindAvailableSlots(context.Context, *FindAvailableSlotsRequest) (*FindAvailableSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAvailableSlots not implemented")
+}
+func (UnimplementedBookingServiceServer) FindNextAvailableSlot(context.Context, *FindNextAvailableSlotRequest) (*FindNextAvailableSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNextAvailableSlot not implemented")
+}
+func (UnimplementedBookingServiceServer) GetMyQuota(context.Context, *GetMyQuotaRequest) (*GetMyQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyQuota not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateBookingSeries(context.Context, *CreateBookingSeriesRequest) (*CreateBookingSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookingSeries not implemented")
+}
+func (UnimplementedBookingServiceServer) GetMyBookingSeries(context.Context, *GetMyBookingSeriesRequest) (*GetMyBookingSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyBookingSeries not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBookingSeries(context.Context, *CancelBookingSeriesRequest) (*CancelBookingSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBookingSeries not implemented")
+}
+func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
+func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingServiceServer will
+// result in compilation errors.
+type UnsafeBookingServiceServer interface {
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingService_ServiceDesc, srv)
+}
+
+func _BookingService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetFacilitySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFacilityScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetFacilitySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetFacilitySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetFacilitySchedule(ctx, req.(*GetFacilityScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookings(ctx, req.(*GetBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetUserBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetUserBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetUserBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetUserBookings(ctx, req.(*GetUserBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_FindAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAvailableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).FindAvailableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_FindAvailableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).FindAvailableSlots(ctx, req.(*FindAvailableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_FindNextAvailableSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNextAvailableSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).FindNextAvailableSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_FindNextAvailableSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).FindNextAvailableSlot(ctx, req.(*FindNextAvailableSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetMyQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetMyQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetMyQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetMyQuota(ctx, req.(*GetMyQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteBooking(ctx, req.(*DeleteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateBookingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBookingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBookingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBookingSeries(ctx, req.(*CreateBookingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetMyBookingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyBookingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetMyBookingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetMyBookingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetMyBookingSeries(ctx, req.(*GetMyBookingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBookingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBookingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBookingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBookingSeries(ctx, req.(*CancelBookingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "booking.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListResources",
+			Handler:    _BookingService_ListResources_Handler,
+		},
+		{
+			MethodName: "GetFacilitySchedule",
+			Handler:    _BookingService_GetFacilitySchedule_Handler,
+		},
+		{
+			MethodName: "CreateBooking",
+			Handler:    _BookingService_CreateBooking_Handler,
+		},
+		{
+			MethodName: "GetBookings",
+			Handler:    _BookingService_GetBookings_Handler,
+		},
+		{
+			MethodName: "GetUserBookings",
+			Handler:    _BookingService_GetUserBookings_Handler,
+		},
+		{
+			MethodName: "FindAvailableSlots",
+			Handler:    _BookingService_FindAvailableSlots_Handler,
+		},
+		{
+			MethodName: "FindNextAvailableSlot",
+			Handler:    _BookingService_FindNextAvailableSlot_Handler,
+		},
+		{
+			MethodName: "GetMyQuota",
+			Handler:    _BookingService_GetMyQuota_Handler,
+		},
+		{
+			MethodName: "DeleteBooking",
+			Handler:    _BookingService_DeleteBooking_Handler,
+		},
+		{
+			MethodName: "CreateBookingSeries",
+			Handler:    _BookingService_CreateBookingSeries_Handler,
+		},
+		{
+			MethodName: "GetMyBookingSeries",
+			Handler:    _BookingService_GetMyBookingSeries_Handler,
+		},
+		{
+			MethodName: "CancelBookingSeries",
+			Handler:    _BookingService_CancelBookingSeries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking/booking_service.proto",
+}
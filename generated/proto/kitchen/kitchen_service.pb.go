@@ -25,7 +25,10 @@ const (
 
 // Сообщение для создания записи на кухню
 type CreateKitchenBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
@@ -63,6 +66,7 @@ func (*CreateKitchenBookingRequest) Descriptor() ([]byte, []int) {
 	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
 func (x *CreateKitchenBookingRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -303,8 +307,11 @@ func (x *GetKitchenBookingsResponse) GetBookings() []*KitchenBooking {
 
 // Сообщение для получения записей пользователя
 type GetUserKitchenBookingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -339,6 +346,7 @@ func (*GetUserKitchenBookingsRequest) Descriptor() ([]byte, []int) {
 	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{5}
 }
 
+// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
 func (x *GetUserKitchenBookingsRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -392,9 +400,12 @@ func (x *GetUserKitchenBookingsResponse) GetBookings() []*KitchenBooking {
 
 // Сообщение для удаления записи
 type DeleteKitchenBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	BookingId     int32                  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -429,6 +440,7 @@ func (*DeleteKitchenBookingRequest) Descriptor() ([]byte, []int) {
 	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{7}
 }
 
+// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
 func (x *DeleteKitchenBookingRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -487,13 +499,513 @@ func (x *DeleteKitchenBookingResponse) GetMessage() string {
 	return ""
 }
 
+// Сообщение для изменения записи на кухню. Незаполненные поля не меняются.
+// У начавшейся записи можно изменить только end_time
+type UpdateKitchenBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32                  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitchenBookingRequest) Reset() {
+	*x = UpdateKitchenBookingRequest{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitchenBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitchenBookingRequest) ProtoMessage() {}
+
+func (x *UpdateKitchenBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitchenBookingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKitchenBookingRequest) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{9}
+}
+
+// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
+func (x *UpdateKitchenBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateKitchenBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *UpdateKitchenBookingRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateKitchenBookingRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type UpdateKitchenBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *KitchenBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitchenBookingResponse) Reset() {
+	*x = UpdateKitchenBookingResponse{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitchenBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitchenBookingResponse) ProtoMessage() {}
+
+func (x *UpdateKitchenBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitchenBookingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKitchenBookingResponse) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateKitchenBookingResponse) GetBooking() *KitchenBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *UpdateKitchenBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для продления записи на кухню, если следующее время свободно
+type ExtendKitchenBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Minutes       int32  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendKitchenBookingRequest) Reset() {
+	*x = ExtendKitchenBookingRequest{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendKitchenBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendKitchenBookingRequest) ProtoMessage() {}
+
+func (x *ExtendKitchenBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendKitchenBookingRequest.ProtoReflect.Descriptor instead.
+func (*ExtendKitchenBookingRequest) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{11}
+}
+
+// Deprecated: Marked as deprecated in kitchen/kitchen_service.proto.
+func (x *ExtendKitchenBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExtendKitchenBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *ExtendKitchenBookingRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type ExtendKitchenBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *KitchenBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendKitchenBookingResponse) Reset() {
+	*x = ExtendKitchenBookingResponse{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendKitchenBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendKitchenBookingResponse) ProtoMessage() {}
+
+func (x *ExtendKitchenBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendKitchenBookingResponse.ProtoReflect.Descriptor instead.
+func (*ExtendKitchenBookingResponse) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExtendKitchenBookingResponse) GetBooking() *KitchenBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ExtendKitchenBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для поиска свободных слотов на кухне
+type FindAvailableKitchenSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Первый и последний день поиска включительно (YYYY-MM-DD) по времени общежития.
+	// По умолчанию сегодня, to_date - from_date. Не больше 14 дней
+	FromDate        *string `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"`
+	ToDate          *string `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date,omitempty"`
+	DurationMinutes int32   `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Сколько слотов вернуть, по умолчанию 50, не больше 200
+	Limit         *int32 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableKitchenSlotsRequest) Reset() {
+	*x = FindAvailableKitchenSlotsRequest{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableKitchenSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableKitchenSlotsRequest) ProtoMessage() {}
+
+func (x *FindAvailableKitchenSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableKitchenSlotsRequest.ProtoReflect.Descriptor instead.
+func (*FindAvailableKitchenSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FindAvailableKitchenSlotsRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *FindAvailableKitchenSlotsRequest) GetToDate() string {
+	if x != nil && x.ToDate != nil {
+		return *x.ToDate
+	}
+	return ""
+}
+
+func (x *FindAvailableKitchenSlotsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *FindAvailableKitchenSlotsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type KitchenSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenSlot) Reset() {
+	*x = KitchenSlot{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenSlot) ProtoMessage() {}
+
+func (x *KitchenSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenSlot.ProtoReflect.Descriptor instead.
+func (*KitchenSlot) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KitchenSlot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *KitchenSlot) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type FindAvailableKitchenSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*KitchenSlot         `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableKitchenSlotsResponse) Reset() {
+	*x = FindAvailableKitchenSlotsResponse{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableKitchenSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableKitchenSlotsResponse) ProtoMessage() {}
+
+func (x *FindAvailableKitchenSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableKitchenSlotsResponse.ProtoReflect.Descriptor instead.
+func (*FindAvailableKitchenSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FindAvailableKitchenSlotsResponse) GetSlots() []*KitchenSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Сообщение для поиска ближайшего свободного слота на кухне начиная с текущего момента
+type FindNextKitchenSlotRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DurationMinutes int32                  `protobuf:"varint,1,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindNextKitchenSlotRequest) Reset() {
+	*x = FindNextKitchenSlotRequest{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextKitchenSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextKitchenSlotRequest) ProtoMessage() {}
+
+func (x *FindNextKitchenSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextKitchenSlotRequest.ProtoReflect.Descriptor instead.
+func (*FindNextKitchenSlotRequest) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FindNextKitchenSlotRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type FindNextKitchenSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *KitchenSlot           `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNextKitchenSlotResponse) Reset() {
+	*x = FindNextKitchenSlotResponse{}
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextKitchenSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextKitchenSlotResponse) ProtoMessage() {}
+
+func (x *FindNextKitchenSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitchen_kitchen_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextKitchenSlotResponse.ProtoReflect.Descriptor instead.
+func (*FindNextKitchenSlotResponse) Descriptor() ([]byte, []int) {
+	return file_kitchen_kitchen_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FindNextKitchenSlotResponse) GetSlot() *KitchenSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
 var File_kitchen_kitchen_service_proto protoreflect.FileDescriptor
 
 const file_kitchen_kitchen_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dkitchen/kitchen_service.proto\x12\akitchen\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa5\x01\n" +
-	"\x1bCreateKitchenBookingRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\x1dkitchen/kitchen_service.proto\x12\akitchen\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa9\x01\n" +
+	"\x1bCreateKitchenBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x129\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"W\n" +
@@ -514,22 +1026,66 @@ const file_kitchen_kitchen_service_proto_rawDesc = "" +
 	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"Q\n" +
 	"\x1aGetKitchenBookingsResponse\x123\n" +
-	"\bbookings\x18\x01 \x03(\v2\x17.kitchen.KitchenBookingR\bbookings\"5\n" +
-	"\x1dGetUserKitchenBookingsRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"U\n" +
+	"\bbookings\x18\x01 \x03(\v2\x17.kitchen.KitchenBookingR\bbookings\"9\n" +
+	"\x1dGetUserKitchenBookingsRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\"U\n" +
 	"\x1eGetUserKitchenBookingsResponse\x123\n" +
-	"\bbookings\x18\x01 \x03(\v2\x17.kitchen.KitchenBookingR\bbookings\"R\n" +
-	"\x1bDeleteKitchenBookingRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\bbookings\x18\x01 \x03(\v2\x17.kitchen.KitchenBookingR\bbookings\"V\n" +
+	"\x1bDeleteKitchenBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x02 \x01(\x05R\tbookingId\"8\n" +
 	"\x1cDeleteKitchenBookingResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xc2\x04\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xee\x01\n" +
+	"\x1bUpdateKitchenBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\x12>\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"k\n" +
+	"\x1cUpdateKitchenBookingResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.kitchen.KitchenBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"p\n" +
+	"\x1bExtendKitchenBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x05R\aminutes\"k\n" +
+	"\x1cExtendKitchenBookingResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.kitchen.KitchenBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xcc\x01\n" +
+	" FindAvailableKitchenSlotsRequest\x12 \n" +
+	"\tfrom_date\x18\x01 \x01(\tH\x00R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x02 \x01(\tH\x01R\x06toDate\x88\x01\x01\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x02R\x05limit\x88\x01\x01B\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_dateB\b\n" +
+	"\x06_limit\"\x7f\n" +
+	"\vKitchenSlot\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"O\n" +
+	"!FindAvailableKitchenSlotsResponse\x12*\n" +
+	"\x05slots\x18\x01 \x03(\v2\x14.kitchen.KitchenSlotR\x05slots\"G\n" +
+	"\x1aFindNextKitchenSlotRequest\x12)\n" +
+	"\x10duration_minutes\x18\x01 \x01(\x05R\x0fdurationMinutes\"G\n" +
+	"\x1bFindNextKitchenSlotResponse\x12(\n" +
+	"\x04slot\x18\x01 \x01(\v2\x14.kitchen.KitchenSlotR\x04slot2\x94\t\n" +
 	"\x0eKitchenService\x12\x88\x01\n" +
 	"\x14CreateKitchenBooking\x12$.kitchen.CreateKitchenBookingRequest\x1a%.kitchen.CreateKitchenBookingResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/kitchen/bookings\x12\x7f\n" +
-	"\x12GetKitchenBookings\x12\".kitchen.GetKitchenBookingsRequest\x1a#.kitchen.GetKitchenBookingsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/kitchen/bookings\x12\x8e\x01\n" +
+	"\x12GetKitchenBookings\x12\".kitchen.GetKitchenBookingsRequest\x1a#.kitchen.GetKitchenBookingsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/kitchen/bookings\x12\x91\x01\n" +
+	"\x19FindAvailableKitchenSlots\x12).kitchen.FindAvailableKitchenSlotsRequest\x1a*.kitchen.FindAvailableKitchenSlotsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/kitchen/slots\x12\x84\x01\n" +
+	"\x13FindNextKitchenSlot\x12#.kitchen.FindNextKitchenSlotRequest\x1a$.kitchen.FindNextKitchenSlotResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/kitchen/slots/next\x12\x8e\x01\n" +
 	"\x16GetUserKitchenBookings\x12&.kitchen.GetUserKitchenBookingsRequest\x1a'.kitchen.GetUserKitchenBookingsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/kitchen/bookings/my\x12\x92\x01\n" +
-	"\x14DeleteKitchenBooking\x12$.kitchen.DeleteKitchenBookingRequest\x1a%.kitchen.DeleteKitchenBookingResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/kitchen/bookings/{booking_id}B:Z8dormitory-helper-service/generated/proto/kitchen;kitchenb\x06proto3"
+	"\x14DeleteKitchenBooking\x12$.kitchen.DeleteKitchenBookingRequest\x1a%.kitchen.DeleteKitchenBookingResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/kitchen/bookings/{booking_id}\x12\x95\x01\n" +
+	"\x14UpdateKitchenBooking\x12$.kitchen.UpdateKitchenBookingRequest\x1a%.kitchen.UpdateKitchenBookingResponse\"0\x82\xd3\xe4\x93\x02*:\x01*2%/api/v1/kitchen/bookings/{booking_id}\x12\x9c\x01\n" +
+	"\x14ExtendKitchenBooking\x12$.kitchen.ExtendKitchenBookingRequest\x1a%.kitchen.ExtendKitchenBookingResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/kitchen/bookings/{booking_id}/extendB:Z8dormitory-helper-service/generated/proto/kitchen;kitchenb\x06proto3"
 
 var (
 	file_kitchen_kitchen_service_proto_rawDescOnce sync.Once
@@ -543,41 +1099,66 @@ func file_kitchen_kitchen_service_proto_rawDescGZIP() []byte {
 	return file_kitchen_kitchen_service_proto_rawDescData
 }
 
-var file_kitchen_kitchen_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_kitchen_kitchen_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_kitchen_kitchen_service_proto_goTypes = []any{
-	(*CreateKitchenBookingRequest)(nil),    // 0: kitchen.CreateKitchenBookingRequest
-	(*CreateKitchenBookingResponse)(nil),   // 1: kitchen.CreateKitchenBookingResponse
-	(*GetKitchenBookingsRequest)(nil),      // 2: kitchen.GetKitchenBookingsRequest
-	(*KitchenBooking)(nil),                 // 3: kitchen.KitchenBooking
-	(*GetKitchenBookingsResponse)(nil),     // 4: kitchen.GetKitchenBookingsResponse
-	(*GetUserKitchenBookingsRequest)(nil),  // 5: kitchen.GetUserKitchenBookingsRequest
-	(*GetUserKitchenBookingsResponse)(nil), // 6: kitchen.GetUserKitchenBookingsResponse
-	(*DeleteKitchenBookingRequest)(nil),    // 7: kitchen.DeleteKitchenBookingRequest
-	(*DeleteKitchenBookingResponse)(nil),   // 8: kitchen.DeleteKitchenBookingResponse
-	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+	(*CreateKitchenBookingRequest)(nil),       // 0: kitchen.CreateKitchenBookingRequest
+	(*CreateKitchenBookingResponse)(nil),      // 1: kitchen.CreateKitchenBookingResponse
+	(*GetKitchenBookingsRequest)(nil),         // 2: kitchen.GetKitchenBookingsRequest
+	(*KitchenBooking)(nil),                    // 3: kitchen.KitchenBooking
+	(*GetKitchenBookingsResponse)(nil),        // 4: kitchen.GetKitchenBookingsResponse
+	(*GetUserKitchenBookingsRequest)(nil),     // 5: kitchen.GetUserKitchenBookingsRequest
+	(*GetUserKitchenBookingsResponse)(nil),    // 6: kitchen.GetUserKitchenBookingsResponse
+	(*DeleteKitchenBookingRequest)(nil),       // 7: kitchen.DeleteKitchenBookingRequest
+	(*DeleteKitchenBookingResponse)(nil),      // 8: kitchen.DeleteKitchenBookingResponse
+	(*UpdateKitchenBookingRequest)(nil),       // 9: kitchen.UpdateKitchenBookingRequest
+	(*UpdateKitchenBookingResponse)(nil),      // 10: kitchen.UpdateKitchenBookingResponse
+	(*ExtendKitchenBookingRequest)(nil),       // 11: kitchen.ExtendKitchenBookingRequest
+	(*ExtendKitchenBookingResponse)(nil),      // 12: kitchen.ExtendKitchenBookingResponse
+	(*FindAvailableKitchenSlotsRequest)(nil),  // 13: kitchen.FindAvailableKitchenSlotsRequest
+	(*KitchenSlot)(nil),                       // 14: kitchen.KitchenSlot
+	(*FindAvailableKitchenSlotsResponse)(nil), // 15: kitchen.FindAvailableKitchenSlotsResponse
+	(*FindNextKitchenSlotRequest)(nil),        // 16: kitchen.FindNextKitchenSlotRequest
+	(*FindNextKitchenSlotResponse)(nil),       // 17: kitchen.FindNextKitchenSlotResponse
+	(*timestamppb.Timestamp)(nil),             // 18: google.protobuf.Timestamp
 }
 var file_kitchen_kitchen_service_proto_depIdxs = []int32{
-	9,  // 0: kitchen.CreateKitchenBookingRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 1: kitchen.CreateKitchenBookingRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 2: kitchen.GetKitchenBookingsRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 3: kitchen.GetKitchenBookingsRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: kitchen.KitchenBooking.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 5: kitchen.KitchenBooking.end_time:type_name -> google.protobuf.Timestamp
+	18, // 0: kitchen.CreateKitchenBookingRequest.start_time:type_name -> google.protobuf.Timestamp
+	18, // 1: kitchen.CreateKitchenBookingRequest.end_time:type_name -> google.protobuf.Timestamp
+	18, // 2: kitchen.GetKitchenBookingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	18, // 3: kitchen.GetKitchenBookingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	18, // 4: kitchen.KitchenBooking.start_time:type_name -> google.protobuf.Timestamp
+	18, // 5: kitchen.KitchenBooking.end_time:type_name -> google.protobuf.Timestamp
 	3,  // 6: kitchen.GetKitchenBookingsResponse.bookings:type_name -> kitchen.KitchenBooking
 	3,  // 7: kitchen.GetUserKitchenBookingsResponse.bookings:type_name -> kitchen.KitchenBooking
-	0,  // 8: kitchen.KitchenService.CreateKitchenBooking:input_type -> kitchen.CreateKitchenBookingRequest
-	2,  // 9: kitchen.KitchenService.GetKitchenBookings:input_type -> kitchen.GetKitchenBookingsRequest
-	5,  // 10: kitchen.KitchenService.GetUserKitchenBookings:input_type -> kitchen.GetUserKitchenBookingsRequest
-	7,  // 11: kitchen.KitchenService.DeleteKitchenBooking:input_type -> kitchen.DeleteKitchenBookingRequest
-	1,  // 12: kitchen.KitchenService.CreateKitchenBooking:output_type -> kitchen.CreateKitchenBookingResponse
-	4,  // 13: kitchen.KitchenService.GetKitchenBookings:output_type -> kitchen.GetKitchenBookingsResponse
-	6,  // 14: kitchen.KitchenService.GetUserKitchenBookings:output_type -> kitchen.GetUserKitchenBookingsResponse
-	8,  // 15: kitchen.KitchenService.DeleteKitchenBooking:output_type -> kitchen.DeleteKitchenBookingResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	18, // 8: kitchen.UpdateKitchenBookingRequest.start_time:type_name -> google.protobuf.Timestamp
+	18, // 9: kitchen.UpdateKitchenBookingRequest.end_time:type_name -> google.protobuf.Timestamp
+	3,  // 10: kitchen.UpdateKitchenBookingResponse.booking:type_name -> kitchen.KitchenBooking
+	3,  // 11: kitchen.ExtendKitchenBookingResponse.booking:type_name -> kitchen.KitchenBooking
+	18, // 12: kitchen.KitchenSlot.start_time:type_name -> google.protobuf.Timestamp
+	18, // 13: kitchen.KitchenSlot.end_time:type_name -> google.protobuf.Timestamp
+	14, // 14: kitchen.FindAvailableKitchenSlotsResponse.slots:type_name -> kitchen.KitchenSlot
+	14, // 15: kitchen.FindNextKitchenSlotResponse.slot:type_name -> kitchen.KitchenSlot
+	0,  // 16: kitchen.KitchenService.CreateKitchenBooking:input_type -> kitchen.CreateKitchenBookingRequest
+	2,  // 17: kitchen.KitchenService.GetKitchenBookings:input_type -> kitchen.GetKitchenBookingsRequest
+	13, // 18: kitchen.KitchenService.FindAvailableKitchenSlots:input_type -> kitchen.FindAvailableKitchenSlotsRequest
+	16, // 19: kitchen.KitchenService.FindNextKitchenSlot:input_type -> kitchen.FindNextKitchenSlotRequest
+	5,  // 20: kitchen.KitchenService.GetUserKitchenBookings:input_type -> kitchen.GetUserKitchenBookingsRequest
+	7,  // 21: kitchen.KitchenService.DeleteKitchenBooking:input_type -> kitchen.DeleteKitchenBookingRequest
+	9,  // 22: kitchen.KitchenService.UpdateKitchenBooking:input_type -> kitchen.UpdateKitchenBookingRequest
+	11, // 23: kitchen.KitchenService.ExtendKitchenBooking:input_type -> kitchen.ExtendKitchenBookingRequest
+	1,  // 24: kitchen.KitchenService.CreateKitchenBooking:output_type -> kitchen.CreateKitchenBookingResponse
+	4,  // 25: kitchen.KitchenService.GetKitchenBookings:output_type -> kitchen.GetKitchenBookingsResponse
+	15, // 26: kitchen.KitchenService.FindAvailableKitchenSlots:output_type -> kitchen.FindAvailableKitchenSlotsResponse
+	17, // 27: kitchen.KitchenService.FindNextKitchenSlot:output_type -> kitchen.FindNextKitchenSlotResponse
+	6,  // 28: kitchen.KitchenService.GetUserKitchenBookings:output_type -> kitchen.GetUserKitchenBookingsResponse
+	8,  // 29: kitchen.KitchenService.DeleteKitchenBooking:output_type -> kitchen.DeleteKitchenBookingResponse
+	10, // 30: kitchen.KitchenService.UpdateKitchenBooking:output_type -> kitchen.UpdateKitchenBookingResponse
+	12, // 31: kitchen.KitchenService.ExtendKitchenBooking:output_type -> kitchen.ExtendKitchenBookingResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_kitchen_kitchen_service_proto_init() }
@@ -586,13 +1167,15 @@ func file_kitchen_kitchen_service_proto_init() {
 		return
 	}
 	file_kitchen_kitchen_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_kitchen_kitchen_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_kitchen_kitchen_service_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kitchen_kitchen_service_proto_rawDesc), len(file_kitchen_kitchen_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
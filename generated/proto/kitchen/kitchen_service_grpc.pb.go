@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	KitchenService_CreateKitchenBooking_FullMethodName   = "/kitchen.KitchenService/CreateKitchenBooking"
-	KitchenService_GetKitchenBookings_FullMethodName     = "/kitchen.KitchenService/GetKitchenBookings"
-	KitchenService_GetUserKitchenBookings_FullMethodName = "/kitchen.KitchenService/GetUserKitchenBookings"
-	KitchenService_DeleteKitchenBooking_FullMethodName   = "/kitchen.KitchenService/DeleteKitchenBooking"
+	KitchenService_CreateKitchenBooking_FullMethodName      = "/kitchen.KitchenService/CreateKitchenBooking"
+	KitchenService_GetKitchenBookings_FullMethodName        = "/kitchen.KitchenService/GetKitchenBookings"
+	KitchenService_FindAvailableKitchenSlots_FullMethodName = "/kitchen.KitchenService/FindAvailableKitchenSlots"
+	KitchenService_FindNextKitchenSlot_FullMethodName       = "/kitchen.KitchenService/FindNextKitchenSlot"
+	KitchenService_GetUserKitchenBookings_FullMethodName    = "/kitchen.KitchenService/GetUserKitchenBookings"
+	KitchenService_DeleteKitchenBooking_FullMethodName      = "/kitchen.KitchenService/DeleteKitchenBooking"
+	KitchenService_UpdateKitchenBooking_FullMethodName      = "/kitchen.KitchenService/UpdateKitchenBooking"
+	KitchenService_ExtendKitchenBooking_FullMethodName      = "/kitchen.KitchenService/ExtendKitchenBooking"
 )
 
 // KitchenServiceClient is the client API for KitchenService service.
@@ -31,8 +35,12 @@ const (
 type KitchenServiceClient interface {
 	CreateKitchenBooking(ctx context.Context, in *CreateKitchenBookingRequest, opts ...grpc.CallOption) (*CreateKitchenBookingResponse, error)
 	GetKitchenBookings(ctx context.Context, in *GetKitchenBookingsRequest, opts ...grpc.CallOption) (*GetKitchenBookingsResponse, error)
+	FindAvailableKitchenSlots(ctx context.Context, in *FindAvailableKitchenSlotsRequest, opts ...grpc.CallOption) (*FindAvailableKitchenSlotsResponse, error)
+	FindNextKitchenSlot(ctx context.Context, in *FindNextKitchenSlotRequest, opts ...grpc.CallOption) (*FindNextKitchenSlotResponse, error)
 	GetUserKitchenBookings(ctx context.Context, in *GetUserKitchenBookingsRequest, opts ...grpc.CallOption) (*GetUserKitchenBookingsResponse, error)
 	DeleteKitchenBooking(ctx context.Context, in *DeleteKitchenBookingRequest, opts ...grpc.CallOption) (*DeleteKitchenBookingResponse, error)
+	UpdateKitchenBooking(ctx context.Context, in *UpdateKitchenBookingRequest, opts ...grpc.CallOption) (*UpdateKitchenBookingResponse, error)
+	ExtendKitchenBooking(ctx context.Context, in *ExtendKitchenBookingRequest, opts ...grpc.CallOption) (*ExtendKitchenBookingResponse, error)
 }
 
 type kitchenServiceClient struct {
@@ -63,6 +71,26 @@ func (c *kitchenServiceClient) GetKitchenBookings(ctx context.Context, in *GetKi
 	return out, nil
 }
 
+func (c *kitchenServiceClient) FindAvailableKitchenSlots(ctx context.Context, in *FindAvailableKitchenSlotsRequest, opts ...grpc.CallOption) (*FindAvailableKitchenSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindAvailableKitchenSlotsResponse)
+	err := c.cc.Invoke(ctx, KitchenService_FindAvailableKitchenSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitchenServiceClient) FindNextKitchenSlot(ctx context.Context, in *FindNextKitchenSlotRequest, opts ...grpc.CallOption) (*FindNextKitchenSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNextKitchenSlotResponse)
+	err := c.cc.Invoke(ctx, KitchenService_FindNextKitchenSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kitchenServiceClient) GetUserKitchenBookings(ctx context.Context, in *GetUserKitchenBookingsRequest, opts ...grpc.CallOption) (*GetUserKitchenBookingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserKitchenBookingsResponse)
@@ -83,14 +111,38 @@ func (c *kitchenServiceClient) DeleteKitchenBooking(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *kitchenServiceClient) UpdateKitchenBooking(ctx context.Context, in *UpdateKitchenBookingRequest, opts ...grpc.CallOption) (*UpdateKitchenBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKitchenBookingResponse)
+	err := c.cc.Invoke(ctx, KitchenService_UpdateKitchenBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kitchenServiceClient) ExtendKitchenBooking(ctx context.Context, in *ExtendKitchenBookingRequest, opts ...grpc.CallOption) (*ExtendKitchenBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendKitchenBookingResponse)
+	err := c.cc.Invoke(ctx, KitchenService_ExtendKitchenBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KitchenServiceServer is the server API for KitchenService service.
 // All implementations must embed UnimplementedKitchenServiceServer
 // for forward compatibility.
 type KitchenServiceServer interface {
 	CreateKitchenBooking(context.Context, *CreateKitchenBookingRequest) (*CreateKitchenBookingResponse, error)
 	GetKitchenBookings(context.Context, *GetKitchenBookingsRequest) (*GetKitchenBookingsResponse, error)
+	FindAvailableKitchenSlots(context.Context, *FindAvailableKitchenSlotsRequest) (*FindAvailableKitchenSlotsResponse, error)
+	FindNextKitchenSlot(context.Context, *FindNextKitchenSlotRequest) (*FindNextKitchenSlotResponse, error)
 	GetUserKitchenBookings(context.Context, *GetUserKitchenBookingsRequest) (*GetUserKitchenBookingsResponse, error)
 	DeleteKitchenBooking(context.Context, *DeleteKitchenBookingRequest) (*DeleteKitchenBookingResponse, error)
+	UpdateKitchenBooking(context.Context, *UpdateKitchenBookingRequest) (*UpdateKitchenBookingResponse, error)
+	ExtendKitchenBooking(context.Context, *ExtendKitchenBookingRequest) (*ExtendKitchenBookingResponse, error)
 	mustEmbedUnimplementedKitchenServiceServer()
 }
 
@@ -107,12 +159,24 @@ func (UnimplementedKitchenServiceServer) CreateKitchenBooking(context.Context, *
 func (UnimplementedKitchenServiceServer) GetKitchenBookings(context.Context, *GetKitchenBookingsRequest) (*GetKitchenBookingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKitchenBookings not implemented")
 }
+func (UnimplementedKitchenServiceServer) FindAvailableKitchenSlots(context.Context, *FindAvailableKitchenSlotsRequest) (*FindAvailableKitchenSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAvailableKitchenSlots not implemented")
+}
+func (UnimplementedKitchenServiceServer) FindNextKitchenSlot(context.Context, *FindNextKitchenSlotRequest) (*FindNextKitchenSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNextKitchenSlot not implemented")
+}
 func (UnimplementedKitchenServiceServer) GetUserKitchenBookings(context.Context, *GetUserKitchenBookingsRequest) (*GetUserKitchenBookingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserKitchenBookings not implemented")
 }
 func (UnimplementedKitchenServiceServer) DeleteKitchenBooking(context.Context, *DeleteKitchenBookingRequest) (*DeleteKitchenBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKitchenBooking not implemented")
 }
+func (UnimplementedKitchenServiceServer) UpdateKitchenBooking(context.Context, *UpdateKitchenBookingRequest) (*UpdateKitchenBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKitchenBooking not implemented")
+}
+func (UnimplementedKitchenServiceServer) ExtendKitchenBooking(context.Context, *ExtendKitchenBookingRequest) (*ExtendKitchenBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendKitchenBooking not implemented")
+}
 func (UnimplementedKitchenServiceServer) mustEmbedUnimplementedKitchenServiceServer() {}
 func (UnimplementedKitchenServiceServer) testEmbeddedByValue()                        {}
 
@@ -170,6 +234,42 @@ func _KitchenService_GetKitchenBookings_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KitchenService_FindAvailableKitchenSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAvailableKitchenSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).FindAvailableKitchenSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitchenService_FindAvailableKitchenSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).FindAvailableKitchenSlots(ctx, req.(*FindAvailableKitchenSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitchenService_FindNextKitchenSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNextKitchenSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).FindNextKitchenSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitchenService_FindNextKitchenSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).FindNextKitchenSlot(ctx, req.(*FindNextKitchenSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KitchenService_GetUserKitchenBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserKitchenBookingsRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +306,42 @@ func _KitchenService_DeleteKitchenBooking_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KitchenService_UpdateKitchenBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKitchenBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).UpdateKitchenBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitchenService_UpdateKitchenBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).UpdateKitchenBooking(ctx, req.(*UpdateKitchenBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KitchenService_ExtendKitchenBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendKitchenBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KitchenServiceServer).ExtendKitchenBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KitchenService_ExtendKitchenBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KitchenServiceServer).ExtendKitchenBooking(ctx, req.(*ExtendKitchenBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KitchenService_ServiceDesc is the grpc.ServiceDesc for KitchenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +357,14 @@ var KitchenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKitchenBookings",
 			Handler:    _KitchenService_GetKitchenBookings_Handler,
 		},
+		{
+			MethodName: "FindAvailableKitchenSlots",
+			Handler:    _KitchenService_FindAvailableKitchenSlots_Handler,
+		},
+		{
+			MethodName: "FindNextKitchenSlot",
+			Handler:    _KitchenService_FindNextKitchenSlot_Handler,
+		},
 		{
 			MethodName: "GetUserKitchenBookings",
 			Handler:    _KitchenService_GetUserKitchenBookings_Handler,
@@ -229,6 +373,14 @@ var KitchenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteKitchenBooking",
 			Handler:    _KitchenService_DeleteKitchenBooking_Handler,
 		},
+		{
+			MethodName: "UpdateKitchenBooking",
+			Handler:    _KitchenService_UpdateKitchenBooking_Handler,
+		},
+		{
+			MethodName: "ExtendKitchenBooking",
+			Handler:    _KitchenService_ExtendKitchenBooking_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kitchen/kitchen_service.proto",
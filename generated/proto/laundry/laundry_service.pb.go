@@ -23,19 +23,291 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Сообщение для создания записи на стирку
-type CreateLaundryBookingRequest struct {
+// Тип машины в прачечной
+type MachineType int32
+
+const (
+	MachineType_MACHINE_TYPE_UNSPECIFIED MachineType = 0
+	MachineType_MACHINE_TYPE_WASHER      MachineType = 1
+	MachineType_MACHINE_TYPE_DRYER       MachineType = 2
+)
+
+// Enum value maps for MachineType.
+var (
+	MachineType_name = map[int32]string{
+		0: "MACHINE_TYPE_UNSPECIFIED",
+		1: "MACHINE_TYPE_WASHER",
+		2: "MACHINE_TYPE_DRYER",
+	}
+	MachineType_value = map[string]int32{
+		"MACHINE_TYPE_UNSPECIFIED": 0,
+		"MACHINE_TYPE_WASHER":      1,
+		"MACHINE_TYPE_DRYER":       2,
+	}
+)
+
+func (x MachineType) Enum() *MachineType {
+	p := new(MachineType)
+	*p = x
+	return p
+}
+
+func (x MachineType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MachineType) Descriptor() protoreflect.EnumDescriptor {
+	return file_laundry_laundry_service_proto_enumTypes[0].Descriptor()
+}
+
+func (MachineType) Type() protoreflect.EnumType {
+	return &file_laundry_laundry_service_proto_enumTypes[0]
+}
+
+func (x MachineType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MachineType.Descriptor instead.
+func (MachineType) EnumDescriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Состояние машины в прачечной
+type MachineStatus int32
+
+const (
+	MachineStatus_MACHINE_STATUS_UNSPECIFIED  MachineStatus = 0
+	MachineStatus_MACHINE_STATUS_AVAILABLE    MachineStatus = 1
+	MachineStatus_MACHINE_STATUS_OUT_OF_ORDER MachineStatus = 2
+)
+
+// Enum value maps for MachineStatus.
+var (
+	MachineStatus_name = map[int32]string{
+		0: "MACHINE_STATUS_UNSPECIFIED",
+		1: "MACHINE_STATUS_AVAILABLE",
+		2: "MACHINE_STATUS_OUT_OF_ORDER",
+	}
+	MachineStatus_value = map[string]int32{
+		"MACHINE_STATUS_UNSPECIFIED":  0,
+		"MACHINE_STATUS_AVAILABLE":    1,
+		"MACHINE_STATUS_OUT_OF_ORDER": 2,
+	}
+)
+
+func (x MachineStatus) Enum() *MachineStatus {
+	p := new(MachineStatus)
+	*p = x
+	return p
+}
+
+func (x MachineStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MachineStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_laundry_laundry_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MachineStatus) Type() protoreflect.EnumType {
+	return &file_laundry_laundry_service_proto_enumTypes[1]
+}
+
+func (x MachineStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MachineStatus.Descriptor instead.
+func (MachineStatus) EnumDescriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{1}
+}
+
+type LaundryMachine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          MachineType            `protobuf:"varint,3,opt,name=type,proto3,enum=laundry.MachineType" json:"type,omitempty"`
+	Floor         int32                  `protobuf:"varint,4,opt,name=floor,proto3" json:"floor,omitempty"`
+	Status        MachineStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=laundry.MachineStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaundryMachine) Reset() {
+	*x = LaundryMachine{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaundryMachine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaundryMachine) ProtoMessage() {}
+
+func (x *LaundryMachine) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaundryMachine.ProtoReflect.Descriptor instead.
+func (*LaundryMachine) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LaundryMachine) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LaundryMachine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LaundryMachine) GetType() MachineType {
+	if x != nil {
+		return x.Type
+	}
+	return MachineType_MACHINE_TYPE_UNSPECIFIED
+}
+
+func (x *LaundryMachine) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *LaundryMachine) GetStatus() MachineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MachineStatus_MACHINE_STATUS_UNSPECIFIED
+}
+
+// Сообщение для получения списка машин
+type ListLaundryMachinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MachineType            `protobuf:"varint,1,opt,name=type,proto3,enum=laundry.MachineType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaundryMachinesRequest) Reset() {
+	*x = ListLaundryMachinesRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaundryMachinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaundryMachinesRequest) ProtoMessage() {}
+
+func (x *ListLaundryMachinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaundryMachinesRequest.ProtoReflect.Descriptor instead.
+func (*ListLaundryMachinesRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListLaundryMachinesRequest) GetType() MachineType {
+	if x != nil {
+		return x.Type
+	}
+	return MachineType_MACHINE_TYPE_UNSPECIFIED
+}
+
+type ListLaundryMachinesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Machines      []*LaundryMachine      `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaundryMachinesResponse) Reset() {
+	*x = ListLaundryMachinesResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaundryMachinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaundryMachinesResponse) ProtoMessage() {}
+
+func (x *ListLaundryMachinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaundryMachinesResponse.ProtoReflect.Descriptor instead.
+func (*ListLaundryMachinesResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListLaundryMachinesResponse) GetMachines() []*LaundryMachine {
+	if x != nil {
+		return x.Machines
+	}
+	return nil
+}
+
+// Сообщение для создания записи на стирку.
+// Если machine_id не указан, выбирается любая свободная машина типа machine_type
+// (по умолчанию - стиральная).
+type CreateLaundryBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MachineId     *int32                 `protobuf:"varint,4,opt,name=machine_id,json=machineId,proto3,oneof" json:"machine_id,omitempty"`
+	MachineType   MachineType            `protobuf:"varint,5,opt,name=machine_type,json=machineType,proto3,enum=laundry.MachineType" json:"machine_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLaundryBookingRequest) Reset() {
 	*x = CreateLaundryBookingRequest{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[0]
+	mi := &file_laundry_laundry_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -47,7 +319,7 @@ func (x *CreateLaundryBookingRequest) String() string {
 func (*CreateLaundryBookingRequest) ProtoMessage() {}
 
 func (x *CreateLaundryBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[0]
+	mi := &file_laundry_laundry_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,9 +332,10 @@ func (x *CreateLaundryBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLaundryBookingRequest.ProtoReflect.Descriptor instead.
 func (*CreateLaundryBookingRequest) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{0}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{3}
 }
 
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
 func (x *CreateLaundryBookingRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -84,17 +357,32 @@ func (x *CreateLaundryBookingRequest) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CreateLaundryBookingRequest) GetMachineId() int32 {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return 0
+}
+
+func (x *CreateLaundryBookingRequest) GetMachineType() MachineType {
+	if x != nil {
+		return x.MachineType
+	}
+	return MachineType_MACHINE_TYPE_UNSPECIFIED
+}
+
 type CreateLaundryBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MachineId     int32                  `protobuf:"varint,3,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLaundryBookingResponse) Reset() {
 	*x = CreateLaundryBookingResponse{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[1]
+	mi := &file_laundry_laundry_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +394,7 @@ func (x *CreateLaundryBookingResponse) String() string {
 func (*CreateLaundryBookingResponse) ProtoMessage() {}
 
 func (x *CreateLaundryBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[1]
+	mi := &file_laundry_laundry_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +407,7 @@ func (x *CreateLaundryBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLaundryBookingResponse.ProtoReflect.Descriptor instead.
 func (*CreateLaundryBookingResponse) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{1}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateLaundryBookingResponse) GetBookingId() int32 {
@@ -136,18 +424,26 @@ func (x *CreateLaundryBookingResponse) GetMessage() string {
 	return ""
 }
 
+func (x *CreateLaundryBookingResponse) GetMachineId() int32 {
+	if x != nil {
+		return x.MachineId
+	}
+	return 0
+}
+
 // Сообщение для получения всех записей
 type GetLaundryBookingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
 	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	MachineId     *int32                 `protobuf:"varint,3,opt,name=machine_id,json=machineId,proto3,oneof" json:"machine_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetLaundryBookingsRequest) Reset() {
 	*x = GetLaundryBookingsRequest{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[2]
+	mi := &file_laundry_laundry_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -159,7 +455,7 @@ func (x *GetLaundryBookingsRequest) String() string {
 func (*GetLaundryBookingsRequest) ProtoMessage() {}
 
 func (x *GetLaundryBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[2]
+	mi := &file_laundry_laundry_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -172,7 +468,7 @@ func (x *GetLaundryBookingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLaundryBookingsRequest.ProtoReflect.Descriptor instead.
 func (*GetLaundryBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{2}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLaundryBookingsRequest) GetStartTime() *timestamppb.Timestamp {
@@ -189,19 +485,30 @@ func (x *GetLaundryBookingsRequest) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetLaundryBookingsRequest) GetMachineId() int32 {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return 0
+}
+
 type LaundryBooking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MachineId int32                  `protobuf:"varint,5,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	// Когда пользователь отметился о приходе и уходе
+	CheckedInAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_in_at,json=checkedInAt,proto3,oneof" json:"checked_in_at,omitempty"`
+	CheckedOutAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=checked_out_at,json=checkedOutAt,proto3,oneof" json:"checked_out_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LaundryBooking) Reset() {
 	*x = LaundryBooking{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[3]
+	mi := &file_laundry_laundry_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +520,7 @@ func (x *LaundryBooking) String() string {
 func (*LaundryBooking) ProtoMessage() {}
 
 func (x *LaundryBooking) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[3]
+	mi := &file_laundry_laundry_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +533,7 @@ func (x *LaundryBooking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LaundryBooking.ProtoReflect.Descriptor instead.
 func (*LaundryBooking) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{3}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LaundryBooking) GetId() int32 {
@@ -257,6 +564,27 @@ func (x *LaundryBooking) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *LaundryBooking) GetMachineId() int32 {
+	if x != nil {
+		return x.MachineId
+	}
+	return 0
+}
+
+func (x *LaundryBooking) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+func (x *LaundryBooking) GetCheckedOutAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedOutAt
+	}
+	return nil
+}
+
 type GetLaundryBookingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bookings      []*LaundryBooking      `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
@@ -266,7 +594,7 @@ type GetLaundryBookingsResponse struct {
 
 func (x *GetLaundryBookingsResponse) Reset() {
 	*x = GetLaundryBookingsResponse{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[4]
+	mi := &file_laundry_laundry_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +606,7 @@ func (x *GetLaundryBookingsResponse) String() string {
 func (*GetLaundryBookingsResponse) ProtoMessage() {}
 
 func (x *GetLaundryBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[4]
+	mi := &file_laundry_laundry_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +619,7 @@ func (x *GetLaundryBookingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLaundryBookingsResponse.ProtoReflect.Descriptor instead.
 func (*GetLaundryBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{4}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetLaundryBookingsResponse) GetBookings() []*LaundryBooking {
@@ -303,15 +631,18 @@ func (x *GetLaundryBookingsResponse) GetBookings() []*LaundryBooking {
 
 // Сообщение для получения записей пользователя
 type GetUserLaundryBookingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserLaundryBookingsRequest) Reset() {
 	*x = GetUserLaundryBookingsRequest{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[5]
+	mi := &file_laundry_laundry_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +654,7 @@ func (x *GetUserLaundryBookingsRequest) String() string {
 func (*GetUserLaundryBookingsRequest) ProtoMessage() {}
 
 func (x *GetUserLaundryBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[5]
+	mi := &file_laundry_laundry_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,9 +667,10 @@ func (x *GetUserLaundryBookingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLaundryBookingsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserLaundryBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{5}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{8}
 }
 
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
 func (x *GetUserLaundryBookingsRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -355,7 +687,7 @@ type GetUserLaundryBookingsResponse struct {
 
 func (x *GetUserLaundryBookingsResponse) Reset() {
 	*x = GetUserLaundryBookingsResponse{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[6]
+	mi := &file_laundry_laundry_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +699,7 @@ func (x *GetUserLaundryBookingsResponse) String() string {
 func (*GetUserLaundryBookingsResponse) ProtoMessage() {}
 
 func (x *GetUserLaundryBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[6]
+	mi := &file_laundry_laundry_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +712,7 @@ func (x *GetUserLaundryBookingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLaundryBookingsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserLaundryBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{6}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetUserLaundryBookingsResponse) GetBookings() []*LaundryBooking {
@@ -392,16 +724,19 @@ func (x *GetUserLaundryBookingsResponse) GetBookings() []*LaundryBooking {
 
 // Сообщение для удаления записи
 type DeleteLaundryBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	BookingId     int32                  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteLaundryBookingRequest) Reset() {
 	*x = DeleteLaundryBookingRequest{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[7]
+	mi := &file_laundry_laundry_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +748,7 @@ func (x *DeleteLaundryBookingRequest) String() string {
 func (*DeleteLaundryBookingRequest) ProtoMessage() {}
 
 func (x *DeleteLaundryBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[7]
+	mi := &file_laundry_laundry_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,9 +761,10 @@ func (x *DeleteLaundryBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLaundryBookingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLaundryBookingRequest) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{7}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{10}
 }
 
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
 func (x *DeleteLaundryBookingRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -452,7 +788,7 @@ type DeleteLaundryBookingResponse struct {
 
 func (x *DeleteLaundryBookingResponse) Reset() {
 	*x = DeleteLaundryBookingResponse{}
-	mi := &file_laundry_laundry_service_proto_msgTypes[8]
+	mi := &file_laundry_laundry_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +800,7 @@ func (x *DeleteLaundryBookingResponse) String() string {
 func (*DeleteLaundryBookingResponse) ProtoMessage() {}
 
 func (x *DeleteLaundryBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_laundry_laundry_service_proto_msgTypes[8]
+	mi := &file_laundry_laundry_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +813,7 @@ func (x *DeleteLaundryBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLaundryBookingResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLaundryBookingResponse) Descriptor() ([]byte, []int) {
-	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{8}
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteLaundryBookingResponse) GetMessage() string {
@@ -487,49 +823,1052 @@ func (x *DeleteLaundryBookingResponse) GetMessage() string {
 	return ""
 }
 
-var File_laundry_laundry_service_proto protoreflect.FileDescriptor
+// Сообщение для изменения записи на стирку. Незаполненные поля не меняются.
+// У начавшейся записи можно изменить только end_time
+type UpdateLaundryBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token     string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId int32                  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// Перенести запись на другую машину
+	MachineId     *int32 `protobuf:"varint,5,opt,name=machine_id,json=machineId,proto3,oneof" json:"machine_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_laundry_laundry_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1dlaundry/laundry_service.proto\x12\alaundry\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa5\x01\n" +
-	"\x1bCreateLaundryBookingRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
-	"\n" +
-	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"W\n" +
-	"\x1cCreateLaundryBookingResponse\x12\x1d\n" +
-	"\n" +
+func (x *UpdateLaundryBookingRequest) Reset() {
+	*x = UpdateLaundryBookingRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLaundryBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLaundryBookingRequest) ProtoMessage() {}
+
+func (x *UpdateLaundryBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLaundryBookingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLaundryBookingRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{12}
+}
+
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+func (x *UpdateLaundryBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateLaundryBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *UpdateLaundryBookingRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateLaundryBookingRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UpdateLaundryBookingRequest) GetMachineId() int32 {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return 0
+}
+
+type UpdateLaundryBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *LaundryBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLaundryBookingResponse) Reset() {
+	*x = UpdateLaundryBookingResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLaundryBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLaundryBookingResponse) ProtoMessage() {}
+
+func (x *UpdateLaundryBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLaundryBookingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLaundryBookingResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateLaundryBookingResponse) GetBooking() *LaundryBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *UpdateLaundryBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для продления записи на стирку, если следующее время свободно
+type ExtendLaundryBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Minutes       int32  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendLaundryBookingRequest) Reset() {
+	*x = ExtendLaundryBookingRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendLaundryBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendLaundryBookingRequest) ProtoMessage() {}
+
+func (x *ExtendLaundryBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendLaundryBookingRequest.ProtoReflect.Descriptor instead.
+func (*ExtendLaundryBookingRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{14}
+}
+
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+func (x *ExtendLaundryBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExtendLaundryBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *ExtendLaundryBookingRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type ExtendLaundryBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *LaundryBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendLaundryBookingResponse) Reset() {
+	*x = ExtendLaundryBookingResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendLaundryBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendLaundryBookingResponse) ProtoMessage() {}
+
+func (x *ExtendLaundryBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendLaundryBookingResponse.ProtoReflect.Descriptor instead.
+func (*ExtendLaundryBookingResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExtendLaundryBookingResponse) GetBooking() *LaundryBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ExtendLaundryBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для отметки о приходе на запись на стирку. Если передан check_in_token
+// (код из QR-кода на машине), он должен относиться к машине записи
+type CheckInLaundryBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token         string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32   `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CheckInToken  *string `protobuf:"bytes,3,opt,name=check_in_token,json=checkInToken,proto3,oneof" json:"check_in_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInLaundryBookingRequest) Reset() {
+	*x = CheckInLaundryBookingRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInLaundryBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInLaundryBookingRequest) ProtoMessage() {}
+
+func (x *CheckInLaundryBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInLaundryBookingRequest.ProtoReflect.Descriptor instead.
+func (*CheckInLaundryBookingRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{16}
+}
+
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+func (x *CheckInLaundryBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInLaundryBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *CheckInLaundryBookingRequest) GetCheckInToken() string {
+	if x != nil && x.CheckInToken != nil {
+		return *x.CheckInToken
+	}
+	return ""
+}
+
+type CheckInLaundryBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *LaundryBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInLaundryBookingResponse) Reset() {
+	*x = CheckInLaundryBookingResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInLaundryBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInLaundryBookingResponse) ProtoMessage() {}
+
+func (x *CheckInLaundryBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInLaundryBookingResponse.ProtoReflect.Descriptor instead.
+func (*CheckInLaundryBookingResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckInLaundryBookingResponse) GetBooking() *LaundryBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CheckInLaundryBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для отметки о приходе по QR-коду на машине без номера записи.
+// Отметка ставится на текущую запись пользователя на эту машину
+type CheckInLaundryMachineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CheckInToken  string `protobuf:"bytes,2,opt,name=check_in_token,json=checkInToken,proto3" json:"check_in_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInLaundryMachineRequest) Reset() {
+	*x = CheckInLaundryMachineRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInLaundryMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInLaundryMachineRequest) ProtoMessage() {}
+
+func (x *CheckInLaundryMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInLaundryMachineRequest.ProtoReflect.Descriptor instead.
+func (*CheckInLaundryMachineRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{18}
+}
+
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+func (x *CheckInLaundryMachineRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInLaundryMachineRequest) GetCheckInToken() string {
+	if x != nil {
+		return x.CheckInToken
+	}
+	return ""
+}
+
+type CheckInLaundryMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *LaundryBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInLaundryMachineResponse) Reset() {
+	*x = CheckInLaundryMachineResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInLaundryMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInLaundryMachineResponse) ProtoMessage() {}
+
+func (x *CheckInLaundryMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInLaundryMachineResponse.ProtoReflect.Descriptor instead.
+func (*CheckInLaundryMachineResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckInLaundryMachineResponse) GetBooking() *LaundryBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CheckInLaundryMachineResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для отметки об уходе. Если запись еще идет, она заканчивается сейчас
+type CheckOutLaundryBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Устарело: передавайте токен в заголовке "Authorization: Bearer <token>"
+	//
+	// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BookingId     int32  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOutLaundryBookingRequest) Reset() {
+	*x = CheckOutLaundryBookingRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOutLaundryBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutLaundryBookingRequest) ProtoMessage() {}
+
+func (x *CheckOutLaundryBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutLaundryBookingRequest.ProtoReflect.Descriptor instead.
+func (*CheckOutLaundryBookingRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{20}
+}
+
+// Deprecated: Marked as deprecated in laundry/laundry_service.proto.
+func (x *CheckOutLaundryBookingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckOutLaundryBookingRequest) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type CheckOutLaundryBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *LaundryBooking        `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckOutLaundryBookingResponse) Reset() {
+	*x = CheckOutLaundryBookingResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckOutLaundryBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckOutLaundryBookingResponse) ProtoMessage() {}
+
+func (x *CheckOutLaundryBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckOutLaundryBookingResponse.ProtoReflect.Descriptor instead.
+func (*CheckOutLaundryBookingResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckOutLaundryBookingResponse) GetBooking() *LaundryBooking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CheckOutLaundryBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для поиска свободных слотов в прачечной. Если machine_id не задан,
+// слоты ищутся на всех исправных машинах типа machine_type (или всех типов)
+type FindAvailableLaundrySlotsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	MachineId   *int32                 `protobuf:"varint,1,opt,name=machine_id,json=machineId,proto3,oneof" json:"machine_id,omitempty"`
+	MachineType MachineType            `protobuf:"varint,2,opt,name=machine_type,json=machineType,proto3,enum=laundry.MachineType" json:"machine_type,omitempty"`
+	// Первый и последний день поиска включительно (YYYY-MM-DD) по времени общежития.
+	// По умолчанию сегодня, to_date - from_date. Не больше 14 дней
+	FromDate        *string `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"`
+	ToDate          *string `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date,omitempty"`
+	DurationMinutes int32   `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Сколько слотов вернуть, по умолчанию 50, не больше 200
+	Limit         *int32 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableLaundrySlotsRequest) Reset() {
+	*x = FindAvailableLaundrySlotsRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableLaundrySlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableLaundrySlotsRequest) ProtoMessage() {}
+
+func (x *FindAvailableLaundrySlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableLaundrySlotsRequest.ProtoReflect.Descriptor instead.
+func (*FindAvailableLaundrySlotsRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FindAvailableLaundrySlotsRequest) GetMachineId() int32 {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return 0
+}
+
+func (x *FindAvailableLaundrySlotsRequest) GetMachineType() MachineType {
+	if x != nil {
+		return x.MachineType
+	}
+	return MachineType_MACHINE_TYPE_UNSPECIFIED
+}
+
+func (x *FindAvailableLaundrySlotsRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *FindAvailableLaundrySlotsRequest) GetToDate() string {
+	if x != nil && x.ToDate != nil {
+		return *x.ToDate
+	}
+	return ""
+}
+
+func (x *FindAvailableLaundrySlotsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *FindAvailableLaundrySlotsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type LaundrySlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     int32                  `protobuf:"varint,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaundrySlot) Reset() {
+	*x = LaundrySlot{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaundrySlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaundrySlot) ProtoMessage() {}
+
+func (x *LaundrySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaundrySlot.ProtoReflect.Descriptor instead.
+func (*LaundrySlot) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LaundrySlot) GetMachineId() int32 {
+	if x != nil {
+		return x.MachineId
+	}
+	return 0
+}
+
+func (x *LaundrySlot) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LaundrySlot) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type FindAvailableLaundrySlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*LaundrySlot         `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindAvailableLaundrySlotsResponse) Reset() {
+	*x = FindAvailableLaundrySlotsResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindAvailableLaundrySlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAvailableLaundrySlotsResponse) ProtoMessage() {}
+
+func (x *FindAvailableLaundrySlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAvailableLaundrySlotsResponse.ProtoReflect.Descriptor instead.
+func (*FindAvailableLaundrySlotsResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FindAvailableLaundrySlotsResponse) GetSlots() []*LaundrySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Сообщение для поиска ближайшего свободного слота в прачечной начиная с текущего момента
+type FindNextLaundrySlotRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MachineId       *int32                 `protobuf:"varint,1,opt,name=machine_id,json=machineId,proto3,oneof" json:"machine_id,omitempty"`
+	MachineType     MachineType            `protobuf:"varint,2,opt,name=machine_type,json=machineType,proto3,enum=laundry.MachineType" json:"machine_type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindNextLaundrySlotRequest) Reset() {
+	*x = FindNextLaundrySlotRequest{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextLaundrySlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextLaundrySlotRequest) ProtoMessage() {}
+
+func (x *FindNextLaundrySlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextLaundrySlotRequest.ProtoReflect.Descriptor instead.
+func (*FindNextLaundrySlotRequest) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindNextLaundrySlotRequest) GetMachineId() int32 {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return 0
+}
+
+func (x *FindNextLaundrySlotRequest) GetMachineType() MachineType {
+	if x != nil {
+		return x.MachineType
+	}
+	return MachineType_MACHINE_TYPE_UNSPECIFIED
+}
+
+func (x *FindNextLaundrySlotRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type FindNextLaundrySlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *LaundrySlot           `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNextLaundrySlotResponse) Reset() {
+	*x = FindNextLaundrySlotResponse{}
+	mi := &file_laundry_laundry_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNextLaundrySlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNextLaundrySlotResponse) ProtoMessage() {}
+
+func (x *FindNextLaundrySlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_laundry_laundry_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNextLaundrySlotResponse.ProtoReflect.Descriptor instead.
+func (*FindNextLaundrySlotResponse) Descriptor() ([]byte, []int) {
+	return file_laundry_laundry_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FindNextLaundrySlotResponse) GetSlot() *LaundrySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+var File_laundry_laundry_service_proto protoreflect.FileDescriptor
+
+const file_laundry_laundry_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlaundry/laundry_service.proto\x12\alaundry\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xa4\x01\n" +
+	"\x0eLaundryMachine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x14.laundry.MachineTypeR\x04type\x12\x14\n" +
+	"\x05floor\x18\x04 \x01(\x05R\x05floor\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.laundry.MachineStatusR\x06status\"F\n" +
+	"\x1aListLaundryMachinesRequest\x12(\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x14.laundry.MachineTypeR\x04type\"R\n" +
+	"\x1bListLaundryMachinesResponse\x123\n" +
+	"\bmachines\x18\x01 \x03(\v2\x17.laundry.LaundryMachineR\bmachines\"\x95\x02\n" +
+	"\x1bCreateLaundryBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\"\n" +
+	"\n" +
+	"machine_id\x18\x04 \x01(\x05H\x00R\tmachineId\x88\x01\x01\x127\n" +
+	"\fmachine_type\x18\x05 \x01(\x0e2\x14.laundry.MachineTypeR\vmachineTypeB\r\n" +
+	"\v_machine_id\"v\n" +
+	"\x1cCreateLaundryBookingResponse\x12\x1d\n" +
+	"\n" +
 	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xb3\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x03 \x01(\x05R\tmachineId\"\xe6\x01\n" +
 	"\x19GetLaundryBookingsRequest\x12>\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
-	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01B\r\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"machine_id\x18\x03 \x01(\x05H\x02R\tmachineId\x88\x01\x01B\r\n" +
 	"\v_start_timeB\v\n" +
-	"\t_end_time\"\xab\x01\n" +
+	"\t_end_timeB\r\n" +
+	"\v_machine_id\"\xfb\x02\n" +
 	"\x0eLaundryBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x129\n" +
 	"\n" +
 	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"Q\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x05 \x01(\x05R\tmachineId\x12C\n" +
+	"\rchecked_in_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vcheckedInAt\x88\x01\x01\x12E\n" +
+	"\x0echecked_out_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\fcheckedOutAt\x88\x01\x01B\x10\n" +
+	"\x0e_checked_in_atB\x11\n" +
+	"\x0f_checked_out_at\"Q\n" +
 	"\x1aGetLaundryBookingsResponse\x123\n" +
-	"\bbookings\x18\x01 \x03(\v2\x17.laundry.LaundryBookingR\bbookings\"5\n" +
-	"\x1dGetUserLaundryBookingsRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"U\n" +
+	"\bbookings\x18\x01 \x03(\v2\x17.laundry.LaundryBookingR\bbookings\"9\n" +
+	"\x1dGetUserLaundryBookingsRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\"U\n" +
 	"\x1eGetUserLaundryBookingsResponse\x123\n" +
-	"\bbookings\x18\x01 \x03(\v2\x17.laundry.LaundryBookingR\bbookings\"R\n" +
-	"\x1bDeleteLaundryBookingRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\bbookings\x18\x01 \x03(\v2\x17.laundry.LaundryBookingR\bbookings\"V\n" +
+	"\x1bDeleteLaundryBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x02 \x01(\x05R\tbookingId\"8\n" +
 	"\x1cDeleteLaundryBookingResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xc2\x04\n" +
-	"\x0eLaundryService\x12\x88\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa1\x02\n" +
+	"\x1bUpdateLaundryBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\x12>\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"machine_id\x18\x05 \x01(\x05H\x02R\tmachineId\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\r\n" +
+	"\v_machine_id\"k\n" +
+	"\x1cUpdateLaundryBookingResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.laundry.LaundryBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"p\n" +
+	"\x1bExtendLaundryBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x05R\aminutes\"k\n" +
+	"\x1cExtendLaundryBookingResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.laundry.LaundryBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x95\x01\n" +
+	"\x1cCheckInLaundryBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\x12)\n" +
+	"\x0echeck_in_token\x18\x03 \x01(\tH\x00R\fcheckInToken\x88\x01\x01B\x11\n" +
+	"\x0f_check_in_token\"l\n" +
+	"\x1dCheckInLaundryBookingResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.laundry.LaundryBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
+	"\x1cCheckInLaundryMachineRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12$\n" +
+	"\x0echeck_in_token\x18\x02 \x01(\tR\fcheckInToken\"l\n" +
+	"\x1dCheckInLaundryMachineResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.laundry.LaundryBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"X\n" +
+	"\x1dCheckOutLaundryBookingRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x05R\tbookingId\"m\n" +
+	"\x1eCheckOutLaundryBookingResponse\x121\n" +
+	"\abooking\x18\x01 \x01(\v2\x17.laundry.LaundryBookingR\abooking\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb8\x02\n" +
+	" FindAvailableLaundrySlotsRequest\x12\"\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\x05H\x00R\tmachineId\x88\x01\x01\x127\n" +
+	"\fmachine_type\x18\x02 \x01(\x0e2\x14.laundry.MachineTypeR\vmachineType\x12 \n" +
+	"\tfrom_date\x18\x03 \x01(\tH\x01R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x04 \x01(\tH\x02R\x06toDate\x88\x01\x01\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\x12\x19\n" +
+	"\x05limit\x18\x06 \x01(\x05H\x03R\x05limit\x88\x01\x01B\r\n" +
+	"\v_machine_idB\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_dateB\b\n" +
+	"\x06_limit\"\x9e\x01\n" +
+	"\vLaundrySlot\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\x05R\tmachineId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"O\n" +
+	"!FindAvailableLaundrySlotsResponse\x12*\n" +
+	"\x05slots\x18\x01 \x03(\v2\x14.laundry.LaundrySlotR\x05slots\"\xb3\x01\n" +
+	"\x1aFindNextLaundrySlotRequest\x12\"\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\x05H\x00R\tmachineId\x88\x01\x01\x127\n" +
+	"\fmachine_type\x18\x02 \x01(\x0e2\x14.laundry.MachineTypeR\vmachineType\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutesB\r\n" +
+	"\v_machine_id\"G\n" +
+	"\x1bFindNextLaundrySlotResponse\x12(\n" +
+	"\x04slot\x18\x01 \x01(\v2\x14.laundry.LaundrySlotR\x04slot*\\\n" +
+	"\vMachineType\x12\x1c\n" +
+	"\x18MACHINE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MACHINE_TYPE_WASHER\x10\x01\x12\x16\n" +
+	"\x12MACHINE_TYPE_DRYER\x10\x02*n\n" +
+	"\rMachineStatus\x12\x1e\n" +
+	"\x1aMACHINE_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MACHINE_STATUS_AVAILABLE\x10\x01\x12\x1f\n" +
+	"\x1bMACHINE_STATUS_OUT_OF_ORDER\x10\x022\xf3\r\n" +
+	"\x0eLaundryService\x12\x82\x01\n" +
+	"\x13ListLaundryMachines\x12#.laundry.ListLaundryMachinesRequest\x1a$.laundry.ListLaundryMachinesResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/laundry/machines\x12\x88\x01\n" +
 	"\x14CreateLaundryBooking\x12$.laundry.CreateLaundryBookingRequest\x1a%.laundry.CreateLaundryBookingResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/laundry/bookings\x12\x7f\n" +
-	"\x12GetLaundryBookings\x12\".laundry.GetLaundryBookingsRequest\x1a#.laundry.GetLaundryBookingsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/laundry/bookings\x12\x8e\x01\n" +
+	"\x12GetLaundryBookings\x12\".laundry.GetLaundryBookingsRequest\x1a#.laundry.GetLaundryBookingsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/laundry/bookings\x12\x91\x01\n" +
+	"\x19FindAvailableLaundrySlots\x12).laundry.FindAvailableLaundrySlotsRequest\x1a*.laundry.FindAvailableLaundrySlotsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/laundry/slots\x12\x84\x01\n" +
+	"\x13FindNextLaundrySlot\x12#.laundry.FindNextLaundrySlotRequest\x1a$.laundry.FindNextLaundrySlotResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/laundry/slots/next\x12\x8e\x01\n" +
 	"\x16GetUserLaundryBookings\x12&.laundry.GetUserLaundryBookingsRequest\x1a'.laundry.GetUserLaundryBookingsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/laundry/bookings/my\x12\x92\x01\n" +
-	"\x14DeleteLaundryBooking\x12$.laundry.DeleteLaundryBookingRequest\x1a%.laundry.DeleteLaundryBookingResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/laundry/bookings/{booking_id}B:Z8dormitory-helper-service/generated/proto/laundry;laundryb\x06proto3"
+	"\x14DeleteLaundryBooking\x12$.laundry.DeleteLaundryBookingRequest\x1a%.laundry.DeleteLaundryBookingResponse\"-\x82\xd3\xe4\x93\x02'*%/api/v1/laundry/bookings/{booking_id}\x12\x95\x01\n" +
+	"\x14UpdateLaundryBooking\x12$.laundry.UpdateLaundryBookingRequest\x1a%.laundry.UpdateLaundryBookingResponse\"0\x82\xd3\xe4\x93\x02*:\x01*2%/api/v1/laundry/bookings/{booking_id}\x12\x9c\x01\n" +
+	"\x14ExtendLaundryBooking\x12$.laundry.ExtendLaundryBookingRequest\x1a%.laundry.ExtendLaundryBookingResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/laundry/bookings/{booking_id}/extend\x12\xa1\x01\n" +
+	"\x15CheckInLaundryBooking\x12%.laundry.CheckInLaundryBookingRequest\x1a&.laundry.CheckInLaundryBookingResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/laundry/bookings/{booking_id}/check-in\x12\x8b\x01\n" +
+	"\x15CheckInLaundryMachine\x12%.laundry.CheckInLaundryMachineRequest\x1a&.laundry.CheckInLaundryMachineResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/laundry/check-in\x12\xa5\x01\n" +
+	"\x16CheckOutLaundryBooking\x12&.laundry.CheckOutLaundryBookingRequest\x1a'.laundry.CheckOutLaundryBookingResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/laundry/bookings/{booking_id}/check-outB:Z8dormitory-helper-service/generated/proto/laundry;laundryb\x06proto3"
 
 var (
 	file_laundry_laundry_service_proto_rawDescOnce sync.Once
@@ -543,41 +1882,98 @@ func file_laundry_laundry_service_proto_rawDescGZIP() []byte {
 	return file_laundry_laundry_service_proto_rawDescData
 }
 
-var file_laundry_laundry_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_laundry_laundry_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_laundry_laundry_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_laundry_laundry_service_proto_goTypes = []any{
-	(*CreateLaundryBookingRequest)(nil),    // 0: laundry.CreateLaundryBookingRequest
-	(*CreateLaundryBookingResponse)(nil),   // 1: laundry.CreateLaundryBookingResponse
-	(*GetLaundryBookingsRequest)(nil),      // 2: laundry.GetLaundryBookingsRequest
-	(*LaundryBooking)(nil),                 // 3: laundry.LaundryBooking
-	(*GetLaundryBookingsResponse)(nil),     // 4: laundry.GetLaundryBookingsResponse
-	(*GetUserLaundryBookingsRequest)(nil),  // 5: laundry.GetUserLaundryBookingsRequest
-	(*GetUserLaundryBookingsResponse)(nil), // 6: laundry.GetUserLaundryBookingsResponse
-	(*DeleteLaundryBookingRequest)(nil),    // 7: laundry.DeleteLaundryBookingRequest
-	(*DeleteLaundryBookingResponse)(nil),   // 8: laundry.DeleteLaundryBookingResponse
-	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+	(MachineType)(0),                          // 0: laundry.MachineType
+	(MachineStatus)(0),                        // 1: laundry.MachineStatus
+	(*LaundryMachine)(nil),                    // 2: laundry.LaundryMachine
+	(*ListLaundryMachinesRequest)(nil),        // 3: laundry.ListLaundryMachinesRequest
+	(*ListLaundryMachinesResponse)(nil),       // 4: laundry.ListLaundryMachinesResponse
+	(*CreateLaundryBookingRequest)(nil),       // 5: laundry.CreateLaundryBookingRequest
+	(*CreateLaundryBookingResponse)(nil),      // 6: laundry.CreateLaundryBookingResponse
+	(*GetLaundryBookingsRequest)(nil),         // 7: laundry.GetLaundryBookingsRequest
+	(*LaundryBooking)(nil),                    // 8: laundry.LaundryBooking
+	(*GetLaundryBookingsResponse)(nil),        // 9: laundry.GetLaundryBookingsResponse
+	(*GetUserLaundryBookingsRequest)(nil),     // 10: laundry.GetUserLaundryBookingsRequest
+	(*GetUserLaundryBookingsResponse)(nil),    // 11: laundry.GetUserLaundryBookingsResponse
+	(*DeleteLaundryBookingRequest)(nil),       // 12: laundry.DeleteLaundryBookingRequest
+	(*DeleteLaundryBookingResponse)(nil),      // 13: laundry.DeleteLaundryBookingResponse
+	(*UpdateLaundryBookingRequest)(nil),       // 14: laundry.UpdateLaundryBookingRequest
+	(*UpdateLaundryBookingResponse)(nil),      // 15: laundry.UpdateLaundryBookingResponse
+	(*ExtendLaundryBookingRequest)(nil),       // 16: laundry.ExtendLaundryBookingRequest
+	(*ExtendLaundryBookingResponse)(nil),      // 17: laundry.ExtendLaundryBookingResponse
+	(*CheckInLaundryBookingRequest)(nil),      // 18: laundry.CheckInLaundryBookingRequest
+	(*CheckInLaundryBookingResponse)(nil),     // 19: laundry.CheckInLaundryBookingResponse
+	(*CheckInLaundryMachineRequest)(nil),      // 20: laundry.CheckInLaundryMachineRequest
+	(*CheckInLaundryMachineResponse)(nil),     // 21: laundry.CheckInLaundryMachineResponse
+	(*CheckOutLaundryBookingRequest)(nil),     // 22: laundry.CheckOutLaundryBookingRequest
+	(*CheckOutLaundryBookingResponse)(nil),    // 23: laundry.CheckOutLaundryBookingResponse
+	(*FindAvailableLaundrySlotsRequest)(nil),  // 24: laundry.FindAvailableLaundrySlotsRequest
+	(*LaundrySlot)(nil),                       // 25: laundry.LaundrySlot
+	(*FindAvailableLaundrySlotsResponse)(nil), // 26: laundry.FindAvailableLaundrySlotsResponse
+	(*FindNextLaundrySlotRequest)(nil),        // 27: laundry.FindNextLaundrySlotRequest
+	(*FindNextLaundrySlotResponse)(nil),       // 28: laundry.FindNextLaundrySlotResponse
+	(*timestamppb.Timestamp)(nil),             // 29: google.protobuf.Timestamp
 }
 var file_laundry_laundry_service_proto_depIdxs = []int32{
-	9,  // 0: laundry.CreateLaundryBookingRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 1: laundry.CreateLaundryBookingRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 2: laundry.GetLaundryBookingsRequest.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 3: laundry.GetLaundryBookingsRequest.end_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: laundry.LaundryBooking.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 5: laundry.LaundryBooking.end_time:type_name -> google.protobuf.Timestamp
-	3,  // 6: laundry.GetLaundryBookingsResponse.bookings:type_name -> laundry.LaundryBooking
-	3,  // 7: laundry.GetUserLaundryBookingsResponse.bookings:type_name -> laundry.LaundryBooking
-	0,  // 8: laundry.LaundryService.CreateLaundryBooking:input_type -> laundry.CreateLaundryBookingRequest
-	2,  // 9: laundry.LaundryService.GetLaundryBookings:input_type -> laundry.GetLaundryBookingsRequest
-	5,  // 10: laundry.LaundryService.GetUserLaundryBookings:input_type -> laundry.GetUserLaundryBookingsRequest
-	7,  // 11: laundry.LaundryService.DeleteLaundryBooking:input_type -> laundry.DeleteLaundryBookingRequest
-	1,  // 12: laundry.LaundryService.CreateLaundryBooking:output_type -> laundry.CreateLaundryBookingResponse
-	4,  // 13: laundry.LaundryService.GetLaundryBookings:output_type -> laundry.GetLaundryBookingsResponse
-	6,  // 14: laundry.LaundryService.GetUserLaundryBookings:output_type -> laundry.GetUserLaundryBookingsResponse
-	8,  // 15: laundry.LaundryService.DeleteLaundryBooking:output_type -> laundry.DeleteLaundryBookingResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,  // 0: laundry.LaundryMachine.type:type_name -> laundry.MachineType
+	1,  // 1: laundry.LaundryMachine.status:type_name -> laundry.MachineStatus
+	0,  // 2: laundry.ListLaundryMachinesRequest.type:type_name -> laundry.MachineType
+	2,  // 3: laundry.ListLaundryMachinesResponse.machines:type_name -> laundry.LaundryMachine
+	29, // 4: laundry.CreateLaundryBookingRequest.start_time:type_name -> google.protobuf.Timestamp
+	29, // 5: laundry.CreateLaundryBookingRequest.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: laundry.CreateLaundryBookingRequest.machine_type:type_name -> laundry.MachineType
+	29, // 7: laundry.GetLaundryBookingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	29, // 8: laundry.GetLaundryBookingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	29, // 9: laundry.LaundryBooking.start_time:type_name -> google.protobuf.Timestamp
+	29, // 10: laundry.LaundryBooking.end_time:type_name -> google.protobuf.Timestamp
+	29, // 11: laundry.LaundryBooking.checked_in_at:type_name -> google.protobuf.Timestamp
+	29, // 12: laundry.LaundryBooking.checked_out_at:type_name -> google.protobuf.Timestamp
+	8,  // 13: laundry.GetLaundryBookingsResponse.bookings:type_name -> laundry.LaundryBooking
+	8,  // 14: laundry.GetUserLaundryBookingsResponse.bookings:type_name -> laundry.LaundryBooking
+	29, // 15: laundry.UpdateLaundryBookingRequest.start_time:type_name -> google.protobuf.Timestamp
+	29, // 16: laundry.UpdateLaundryBookingRequest.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 17: laundry.UpdateLaundryBookingResponse.booking:type_name -> laundry.LaundryBooking
+	8,  // 18: laundry.ExtendLaundryBookingResponse.booking:type_name -> laundry.LaundryBooking
+	8,  // 19: laundry.CheckInLaundryBookingResponse.booking:type_name -> laundry.LaundryBooking
+	8,  // 20: laundry.CheckInLaundryMachineResponse.booking:type_name -> laundry.LaundryBooking
+	8,  // 21: laundry.CheckOutLaundryBookingResponse.booking:type_name -> laundry.LaundryBooking
+	0,  // 22: laundry.FindAvailableLaundrySlotsRequest.machine_type:type_name -> laundry.MachineType
+	29, // 23: laundry.LaundrySlot.start_time:type_name -> google.protobuf.Timestamp
+	29, // 24: laundry.LaundrySlot.end_time:type_name -> google.protobuf.Timestamp
+	25, // 25: laundry.FindAvailableLaundrySlotsResponse.slots:type_name -> laundry.LaundrySlot
+	0,  // 26: laundry.FindNextLaundrySlotRequest.machine_type:type_name -> laundry.MachineType
+	25, // 27: laundry.FindNextLaundrySlotResponse.slot:type_name -> laundry.LaundrySlot
+	3,  // 28: laundry.LaundryService.ListLaundryMachines:input_type -> laundry.ListLaundryMachinesRequest
+	5,  // 29: laundry.LaundryService.CreateLaundryBooking:input_type -> laundry.CreateLaundryBookingRequest
+	7,  // 30: laundry.LaundryService.GetLaundryBookings:input_type -> laundry.GetLaundryBookingsRequest
+	24, // 31: laundry.LaundryService.FindAvailableLaundrySlots:input_type -> laundry.FindAvailableLaundrySlotsRequest
+	27, // 32: laundry.LaundryService.FindNextLaundrySlot:input_type -> laundry.FindNextLaundrySlotRequest
+	10, // 33: laundry.LaundryService.GetUserLaundryBookings:input_type -> laundry.GetUserLaundryBookingsRequest
+	12, // 34: laundry.LaundryService.DeleteLaundryBooking:input_type -> laundry.DeleteLaundryBookingRequest
+	14, // 35: laundry.LaundryService.UpdateLaundryBooking:input_type -> laundry.UpdateLaundryBookingRequest
+	16, // 36: laundry.LaundryService.ExtendLaundryBooking:input_type -> laundry.ExtendLaundryBookingRequest
+	18, // 37: laundry.LaundryService.CheckInLaundryBooking:input_type -> laundry.CheckInLaundryBookingRequest
+	20, // 38: laundry.LaundryService.CheckInLaundryMachine:input_type -> laundry.CheckInLaundryMachineRequest
+	22, // 39: laundry.LaundryService.CheckOutLaundryBooking:input_type -> laundry.CheckOutLaundryBookingRequest
+	4,  // 40: laundry.LaundryService.ListLaundryMachines:output_type -> laundry.ListLaundryMachinesResponse
+	6,  // 41: laundry.LaundryService.CreateLaundryBooking:output_type -> laundry.CreateLaundryBookingResponse
+	9,  // 42: laundry.LaundryService.GetLaundryBookings:output_type -> laundry.GetLaundryBookingsResponse
+	26, // 43: laundry.LaundryService.FindAvailableLaundrySlots:output_type -> laundry.FindAvailableLaundrySlotsResponse
+	28, // 44: laundry.LaundryService.FindNextLaundrySlot:output_type -> laundry.FindNextLaundrySlotResponse
+	11, // 45: laundry.LaundryService.GetUserLaundryBookings:output_type -> laundry.GetUserLaundryBookingsResponse
+	13, // 46: laundry.LaundryService.DeleteLaundryBooking:output_type -> laundry.DeleteLaundryBookingResponse
+	15, // 47: laundry.LaundryService.UpdateLaundryBooking:output_type -> laundry.UpdateLaundryBookingResponse
+	17, // 48: laundry.LaundryService.ExtendLaundryBooking:output_type -> laundry.ExtendLaundryBookingResponse
+	19, // 49: laundry.LaundryService.CheckInLaundryBooking:output_type -> laundry.CheckInLaundryBookingResponse
+	21, // 50: laundry.LaundryService.CheckInLaundryMachine:output_type -> laundry.CheckInLaundryMachineResponse
+	23, // 51: laundry.LaundryService.CheckOutLaundryBooking:output_type -> laundry.CheckOutLaundryBookingResponse
+	40, // [40:52] is the sub-list for method output_type
+	28, // [28:40] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_laundry_laundry_service_proto_init() }
@@ -585,19 +1981,26 @@ func file_laundry_laundry_service_proto_init() {
 	if File_laundry_laundry_service_proto != nil {
 		return
 	}
-	file_laundry_laundry_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[6].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_laundry_laundry_service_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_laundry_laundry_service_proto_rawDesc), len(file_laundry_laundry_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_laundry_laundry_service_proto_goTypes,
 		DependencyIndexes: file_laundry_laundry_service_proto_depIdxs,
+		EnumInfos:         file_laundry_laundry_service_proto_enumTypes,
 		MessageInfos:      file_laundry_laundry_service_proto_msgTypes,
 	}.Build()
 	File_laundry_laundry_service_proto = out.File
@@ -19,20 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LaundryService_CreateLaundryBooking_FullMethodName   = "/laundry.LaundryService/CreateLaundryBooking"
-	LaundryService_GetLaundryBookings_FullMethodName     = "/laundry.LaundryService/GetLaundryBookings"
-	LaundryService_GetUserLaundryBookings_FullMethodName = "/laundry.LaundryService/GetUserLaundryBookings"
-	LaundryService_DeleteLaundryBooking_FullMethodName   = "/laundry.LaundryService/DeleteLaundryBooking"
+	LaundryService_ListLaundryMachines_FullMethodName       = "/laundry.LaundryService/ListLaundryMachines"
+	LaundryService_CreateLaundryBooking_FullMethodName      = "/laundry.LaundryService/CreateLaundryBooking"
+	LaundryService_GetLaundryBookings_FullMethodName        = "/laundry.LaundryService/GetLaundryBookings"
+	LaundryService_FindAvailableLaundrySlots_FullMethodName = "/laundry.LaundryService/FindAvailableLaundrySlots"
+	LaundryService_FindNextLaundrySlot_FullMethodName       = "/laundry.LaundryService/FindNextLaundrySlot"
+	LaundryService_GetUserLaundryBookings_FullMethodName    = "/laundry.LaundryService/GetUserLaundryBookings"
+	LaundryService_DeleteLaundryBooking_FullMethodName      = "/laundry.LaundryService/DeleteLaundryBooking"
+	LaundryService_UpdateLaundryBooking_FullMethodName      = "/laundry.LaundryService/UpdateLaundryBooking"
+	LaundryService_ExtendLaundryBooking_FullMethodName      = "/laundry.LaundryService/ExtendLaundryBooking"
+	LaundryService_CheckInLaundryBooking_FullMethodName     = "/laundry.LaundryService/CheckInLaundryBooking"
+	LaundryService_CheckInLaundryMachine_FullMethodName     = "/laundry.LaundryService/CheckInLaundryMachine"
+	LaundryService_CheckOutLaundryBooking_FullMethodName    = "/laundry.LaundryService/CheckOutLaundryBooking"
 )
 
 // LaundryServiceClient is the client API for LaundryService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LaundryServiceClient interface {
+	ListLaundryMachines(ctx context.Context, in *ListLaundryMachinesRequest, opts ...grpc.CallOption) (*ListLaundryMachinesResponse, error)
 	CreateLaundryBooking(ctx context.Context, in *CreateLaundryBookingRequest, opts ...grpc.CallOption) (*CreateLaundryBookingResponse, error)
 	GetLaundryBookings(ctx context.Context, in *GetLaundryBookingsRequest, opts ...grpc.CallOption) (*GetLaundryBookingsResponse, error)
+	FindAvailableLaundrySlots(ctx context.Context, in *FindAvailableLaundrySlotsRequest, opts ...grpc.CallOption) (*FindAvailableLaundrySlotsResponse, error)
+	FindNextLaundrySlot(ctx context.Context, in *FindNextLaundrySlotRequest, opts ...grpc.CallOption) (*FindNextLaundrySlotResponse, error)
 	GetUserLaundryBookings(ctx context.Context, in *GetUserLaundryBookingsRequest, opts ...grpc.CallOption) (*GetUserLaundryBookingsResponse, error)
 	DeleteLaundryBooking(ctx context.Context, in *DeleteLaundryBookingRequest, opts ...grpc.CallOption) (*DeleteLaundryBookingResponse, error)
+	UpdateLaundryBooking(ctx context.Context, in *UpdateLaundryBookingRequest, opts ...grpc.CallOption) (*UpdateLaundryBookingResponse, error)
+	ExtendLaundryBooking(ctx context.Context, in *ExtendLaundryBookingRequest, opts ...grpc.CallOption) (*ExtendLaundryBookingResponse, error)
+	CheckInLaundryBooking(ctx context.Context, in *CheckInLaundryBookingRequest, opts ...grpc.CallOption) (*CheckInLaundryBookingResponse, error)
+	CheckInLaundryMachine(ctx context.Context, in *CheckInLaundryMachineRequest, opts ...grpc.CallOption) (*CheckInLaundryMachineResponse, error)
+	CheckOutLaundryBooking(ctx context.Context, in *CheckOutLaundryBookingRequest, opts ...grpc.CallOption) (*CheckOutLaundryBookingResponse, error)
 }
 
 type laundryServiceClient struct {
@@ -43,6 +59,16 @@ func NewLaundryServiceClient(cc grpc.ClientConnInterface) LaundryServiceClient {
 	return &laundryServiceClient{cc}
 }
 
+func (c *laundryServiceClient) ListLaundryMachines(ctx context.Context, in *ListLaundryMachinesRequest, opts ...grpc.CallOption) (*ListLaundryMachinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLaundryMachinesResponse)
+	err := c.cc.Invoke(ctx, LaundryService_ListLaundryMachines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *laundryServiceClient) CreateLaundryBooking(ctx context.Context, in *CreateLaundryBookingRequest, opts ...grpc.CallOption) (*CreateLaundryBookingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLaundryBookingResponse)
@@ -63,6 +89,26 @@ func (c *laundryServiceClient) GetLaundryBookings(ctx context.Context, in *GetLa
 	return out, nil
 }
 
+func (c *laundryServiceClient) FindAvailableLaundrySlots(ctx context.Context, in *FindAvailableLaundrySlotsRequest, opts ...grpc.CallOption) (*FindAvailableLaundrySlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindAvailableLaundrySlotsResponse)
+	err := c.cc.Invoke(ctx, LaundryService_FindAvailableLaundrySlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *laundryServiceClient) FindNextLaundrySlot(ctx context.Context, in *FindNextLaundrySlotRequest, opts ...grpc.CallOption) (*FindNextLaundrySlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNextLaundrySlotResponse)
+	err := c.cc.Invoke(ctx, LaundryService_FindNextLaundrySlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *laundryServiceClient) GetUserLaundryBookings(ctx context.Context, in *GetUserLaundryBookingsRequest, opts ...grpc.CallOption) (*GetUserLaundryBookingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserLaundryBookingsResponse)
@@ -83,14 +129,72 @@ func (c *laundryServiceClient) DeleteLaundryBooking(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *laundryServiceClient) UpdateLaundryBooking(ctx context.Context, in *UpdateLaundryBookingRequest, opts ...grpc.CallOption) (*UpdateLaundryBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLaundryBookingResponse)
+	err := c.cc.Invoke(ctx, LaundryService_UpdateLaundryBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *laundryServiceClient) ExtendLaundryBooking(ctx context.Context, in *ExtendLaundryBookingRequest, opts ...grpc.CallOption) (*ExtendLaundryBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendLaundryBookingResponse)
+	err := c.cc.Invoke(ctx, LaundryService_ExtendLaundryBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *laundryServiceClient) CheckInLaundryBooking(ctx context.Context, in *CheckInLaundryBookingRequest, opts ...grpc.CallOption) (*CheckInLaundryBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInLaundryBookingResponse)
+	err := c.cc.Invoke(ctx, LaundryService_CheckInLaundryBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *laundryServiceClient) CheckInLaundryMachine(ctx context.Context, in *CheckInLaundryMachineRequest, opts ...grpc.CallOption) (*CheckInLaundryMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInLaundryMachineResponse)
+	err := c.cc.Invoke(ctx, LaundryService_CheckInLaundryMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *laundryServiceClient) CheckOutLaundryBooking(ctx context.Context, in *CheckOutLaundryBookingRequest, opts ...grpc.CallOption) (*CheckOutLaundryBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOutLaundryBookingResponse)
+	err := c.cc.Invoke(ctx, LaundryService_CheckOutLaundryBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LaundryServiceServer is the server API for LaundryService service.
 // All implementations must embed UnimplementedLaundryServiceServer
 // for forward compatibility.
 type LaundryServiceServer interface {
+	ListLaundryMachines(context.Context, *ListLaundryMachinesRequest) (*ListLaundryMachinesResponse, error)
 	CreateLaundryBooking(context.Context, *CreateLaundryBookingRequest) (*CreateLaundryBookingResponse, error)
 	GetLaundryBookings(context.Context, *GetLaundryBookingsRequest) (*GetLaundryBookingsResponse, error)
+	FindAvailableLaundrySlots(context.Context, *FindAvailableLaundrySlotsRequest) (*FindAvailableLaundrySlotsResponse, error)
+	FindNextLaundrySlot(context.Context, *FindNextLaundrySlotRequest) (*FindNextLaundrySlotResponse, error)
 	GetUserLaundryBookings(context.Context, *GetUserLaundryBookingsRequest) (*GetUserLaundryBookingsResponse, error)
 	DeleteLaundryBooking(context.Context, *DeleteLaundryBookingRequest) (*DeleteLaundryBookingResponse, error)
+	UpdateLaundryBooking(context.Context, *UpdateLaundryBookingRequest) (*UpdateLaundryBookingResponse, error)
+	ExtendLaundryBooking(context.Context, *ExtendLaundryBookingRequest) (*ExtendLaundryBookingResponse, error)
+	CheckInLaundryBooking(context.Context, *CheckInLaundryBookingRequest) (*CheckInLaundryBookingResponse, error)
+	CheckInLaundryMachine(context.Context, *CheckInLaundryMachineRequest) (*CheckInLaundryMachineResponse, error)
+	CheckOutLaundryBooking(context.Context, *CheckOutLaundryBookingRequest) (*CheckOutLaundryBookingResponse, error)
 	mustEmbedUnimplementedLaundryServiceServer()
 }
 
@@ -101,18 +205,42 @@ type LaundryServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedLaundryServiceServer struct{}
 
+func (UnimplementedLaundryServiceServer) ListLaundryMachines(context.Context, *ListLaundryMachinesRequest) (*ListLaundryMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLaundryMachines not implemented")
+}
 func (UnimplementedLaundryServiceServer) CreateLaundryBooking(context.Context, *CreateLaundryBookingRequest) (*CreateLaundryBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLaundryBooking not implemented")
 }
 func (UnimplementedLaundryServiceServer) GetLaundryBookings(context.Context, *GetLaundryBookingsRequest) (*GetLaundryBookingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLaundryBookings not implemented")
 }
+func (UnimplementedLaundryServiceServer) FindAvailableLaundrySlots(context.Context, *FindAvailableLaundrySlotsRequest) (*FindAvailableLaundrySlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAvailableLaundrySlots not implemented")
+}
+func (UnimplementedLaundryServiceServer) FindNextLaundrySlot(context.Context, *FindNextLaundrySlotRequest) (*FindNextLaundrySlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNextLaundrySlot not implemented")
+}
 func (UnimplementedLaundryServiceServer) GetUserLaundryBookings(context.Context, *GetUserLaundryBookingsRequest) (*GetUserLaundryBookingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserLaundryBookings not implemented")
 }
 func (UnimplementedLaundryServiceServer) DeleteLaundryBooking(context.Context, *DeleteLaundryBookingRequest) (*DeleteLaundryBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLaundryBooking not implemented")
 }
+func (UnimplementedLaundryServiceServer) UpdateLaundryBooking(context.Context, *UpdateLaundryBookingRequest) (*UpdateLaundryBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLaundryBooking not implemented")
+}
+func (UnimplementedLaundryServiceServer) ExtendLaundryBooking(context.Context, *ExtendLaundryBookingRequest) (*ExtendLaundryBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLaundryBooking not implemented")
+}
+func (UnimplementedLaundryServiceServer) CheckInLaundryBooking(context.Context, *CheckInLaundryBookingRequest) (*CheckInLaundryBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInLaundryBooking not implemented")
+}
+func (UnimplementedLaundryServiceServer) CheckInLaundryMachine(context.Context, *CheckInLaundryMachineRequest) (*CheckInLaundryMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInLaundryMachine not implemented")
+}
+func (UnimplementedLaundryServiceServer) CheckOutLaundryBooking(context.Context, *CheckOutLaundryBookingRequest) (*CheckOutLaundryBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOutLaundryBooking not implemented")
+}
 func (UnimplementedLaundryServiceServer) mustEmbedUnimplementedLaundryServiceServer() {}
 func (UnimplementedLaundryServiceServer) testEmbeddedByValue()                        {}
 
@@ -134,6 +262,24 @@ func RegisterLaundryServiceServer(s grpc.ServiceRegistrar, srv LaundryServiceSer
 	s.RegisterService(&LaundryService_ServiceDesc, srv)
 }
 
+func _LaundryService_ListLaundryMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLaundryMachinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).ListLaundryMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_ListLaundryMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).ListLaundryMachines(ctx, req.(*ListLaundryMachinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LaundryService_CreateLaundryBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLaundryBookingRequest)
 	if err := dec(in); err != nil {
@@ -170,6 +316,42 @@ func _LaundryService_GetLaundryBookings_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LaundryService_FindAvailableLaundrySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAvailableLaundrySlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).FindAvailableLaundrySlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_FindAvailableLaundrySlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).FindAvailableLaundrySlots(ctx, req.(*FindAvailableLaundrySlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaundryService_FindNextLaundrySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNextLaundrySlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).FindNextLaundrySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_FindNextLaundrySlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).FindNextLaundrySlot(ctx, req.(*FindNextLaundrySlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LaundryService_GetUserLaundryBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserLaundryBookingsRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +388,96 @@ func _LaundryService_DeleteLaundryBooking_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LaundryService_UpdateLaundryBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLaundryBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).UpdateLaundryBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_UpdateLaundryBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).UpdateLaundryBooking(ctx, req.(*UpdateLaundryBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaundryService_ExtendLaundryBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendLaundryBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).ExtendLaundryBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_ExtendLaundryBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).ExtendLaundryBooking(ctx, req.(*ExtendLaundryBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaundryService_CheckInLaundryBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInLaundryBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).CheckInLaundryBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_CheckInLaundryBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).CheckInLaundryBooking(ctx, req.(*CheckInLaundryBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaundryService_CheckInLaundryMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInLaundryMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).CheckInLaundryMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_CheckInLaundryMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).CheckInLaundryMachine(ctx, req.(*CheckInLaundryMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaundryService_CheckOutLaundryBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutLaundryBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaundryServiceServer).CheckOutLaundryBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaundryService_CheckOutLaundryBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaundryServiceServer).CheckOutLaundryBooking(ctx, req.(*CheckOutLaundryBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LaundryService_ServiceDesc is the grpc.ServiceDesc for LaundryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -213,6 +485,10 @@ var LaundryService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "laundry.LaundryService",
 	HandlerType: (*LaundryServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLaundryMachines",
+			Handler:    _LaundryService_ListLaundryMachines_Handler,
+		},
 		{
 			MethodName: "CreateLaundryBooking",
 			Handler:    _LaundryService_CreateLaundryBooking_Handler,
@@ -221,6 +497,14 @@ var LaundryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLaundryBookings",
 			Handler:    _LaundryService_GetLaundryBookings_Handler,
 		},
+		{
+			MethodName: "FindAvailableLaundrySlots",
+			Handler:    _LaundryService_FindAvailableLaundrySlots_Handler,
+		},
+		{
+			MethodName: "FindNextLaundrySlot",
+			Handler:    _LaundryService_FindNextLaundrySlot_Handler,
+		},
 		{
 			MethodName: "GetUserLaundryBookings",
 			Handler:    _LaundryService_GetUserLaundryBookings_Handler,
@@ -229,6 +513,26 @@ var LaundryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLaundryBooking",
 			Handler:    _LaundryService_DeleteLaundryBooking_Handler,
 		},
+		{
+			MethodName: "UpdateLaundryBooking",
+			Handler:    _LaundryService_UpdateLaundryBooking_Handler,
+		},
+		{
+			MethodName: "ExtendLaundryBooking",
+			Handler:    _LaundryService_ExtendLaundryBooking_Handler,
+		},
+		{
+			MethodName: "CheckInLaundryBooking",
+			Handler:    _LaundryService_CheckInLaundryBooking_Handler,
+		},
+		{
+			MethodName: "CheckInLaundryMachine",
+			Handler:    _LaundryService_CheckInLaundryMachine_Handler,
+		},
+		{
+			MethodName: "CheckOutLaundryBooking",
+			Handler:    _LaundryService_CheckOutLaundryBooking_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "laundry/laundry_service.proto",
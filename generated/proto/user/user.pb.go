@@ -67,10 +67,12 @@ func (x *CheckAuthenticationRequest) GetToken() string {
 }
 
 type CheckAuthenticationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Token    string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// Роль пользователя: "user" или "admin"
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,17 +128,25 @@ func (x *CheckAuthenticationResponse) GetToken() string {
 	return ""
 }
 
+func (x *CheckAuthenticationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 var File_user_user_proto protoreflect.FileDescriptor
 
 const file_user_user_proto_rawDesc = "" +
 	"\n" +
 	"\x0fuser/user.proto\x12\x04user\x1a\x1cgoogle/api/annotations.proto\"2\n" +
 	"\x1aCheckAuthenticationRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"h\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"|\n" +
 	"\x1bCheckAuthenticationResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05token2\x88\x01\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role2\x88\x01\n" +
 	"\vUserService\x12y\n" +
 	"\x13CheckAuthentication\x12 .user.CheckAuthenticationRequest\x1a!.user.CheckAuthenticationResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/checkB4Z2dormitory-helper-service/generated/proto/user;userb\x06proto3"
 
@@ -0,0 +1,764 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: waitlist/waitlist_service.proto
+
+package waitlist
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Состояние заявки в листе ожидания
+type WaitlistStatus int32
+
+const (
+	WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED WaitlistStatus = 0
+	// Ожидает освобождения времени
+	WaitlistStatus_WAITLIST_STATUS_WAITING WaitlistStatus = 1
+	// Запись удерживается до hold_until и должна быть подтверждена
+	WaitlistStatus_WAITLIST_STATUS_HELD WaitlistStatus = 2
+	// Запись получена
+	WaitlistStatus_WAITLIST_STATUS_BOOKED WaitlistStatus = 3
+	// Окно прошло или удержание не подтверждено
+	WaitlistStatus_WAITLIST_STATUS_EXPIRED   WaitlistStatus = 4
+	WaitlistStatus_WAITLIST_STATUS_CANCELLED WaitlistStatus = 5
+)
+
+// Enum value maps for WaitlistStatus.
+var (
+	WaitlistStatus_name = map[int32]string{
+		0: "WAITLIST_STATUS_UNSPECIFIED",
+		1: "WAITLIST_STATUS_WAITING",
+		2: "WAITLIST_STATUS_HELD",
+		3: "WAITLIST_STATUS_BOOKED",
+		4: "WAITLIST_STATUS_EXPIRED",
+		5: "WAITLIST_STATUS_CANCELLED",
+	}
+	WaitlistStatus_value = map[string]int32{
+		"WAITLIST_STATUS_UNSPECIFIED": 0,
+		"WAITLIST_STATUS_WAITING":     1,
+		"WAITLIST_STATUS_HELD":        2,
+		"WAITLIST_STATUS_BOOKED":      3,
+		"WAITLIST_STATUS_EXPIRED":     4,
+		"WAITLIST_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x WaitlistStatus) Enum() *WaitlistStatus {
+	p := new(WaitlistStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_waitlist_waitlist_service_proto_enumTypes[0].Descriptor()
+}
+
+func (WaitlistStatus) Type() protoreflect.EnumType {
+	return &file_waitlist_waitlist_service_proto_enumTypes[0]
+}
+
+func (x WaitlistStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistStatus.Descriptor instead.
+func (WaitlistStatus) EnumDescriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{0}
+}
+
+type WaitlistEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ResourceType string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Не заполнено, если подходит любой ресурс типа resource_type
+	ResourceId      *int32                 `protobuf:"varint,3,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	WindowStart     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	AutoBook        bool                   `protobuf:"varint,7,opt,name=auto_book,json=autoBook,proto3" json:"auto_book,omitempty"`
+	Status          WaitlistStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=waitlist.WaitlistStatus" json:"status,omitempty"`
+	BookingId       *int32                 `protobuf:"varint,9,opt,name=booking_id,json=bookingId,proto3,oneof" json:"booking_id,omitempty"`
+	HoldUntil       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=hold_until,json=holdUntil,proto3,oneof" json:"hold_until,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WaitlistEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetResourceId() int32 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetAutoBook() bool {
+	if x != nil {
+		return x.AutoBook
+	}
+	return false
+}
+
+func (x *WaitlistEntry) GetStatus() WaitlistStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+}
+
+func (x *WaitlistEntry) GetBookingId() int32 {
+	if x != nil && x.BookingId != nil {
+		return *x.BookingId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetHoldUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldUntil
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Сообщение для постановки в лист ожидания.
+// Для конкретного слота передайте его границы как окно без duration_minutes,
+// для "любого времени в окне" - окно и нужную длительность
+type JoinWaitlistRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Конкретный ресурс (например, машина прачечной), иначе любой ресурс типа
+	ResourceId      *int32                 `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	WindowStart     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	DurationMinutes *int32                 `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3,oneof" json:"duration_minutes,omitempty"`
+	// Сразу создать запись, когда время освободится, без подтверждения
+	AutoBook      bool `protobuf:"varint,6,opt,name=auto_book,json=autoBook,proto3" json:"auto_book,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JoinWaitlistRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetResourceId() int32 {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *JoinWaitlistRequest) GetDurationMinutes() int32 {
+	if x != nil && x.DurationMinutes != nil {
+		return *x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetAutoBook() bool {
+	if x != nil {
+		return x.AutoBook
+	}
+	return false
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JoinWaitlistResponse) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *JoinWaitlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для получения заявок текущего пользователя
+type GetMyWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyWaitlistRequest) Reset() {
+	*x = GetMyWaitlistRequest{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyWaitlistRequest) ProtoMessage() {}
+
+func (x *GetMyWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*GetMyWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{3}
+}
+
+type GetMyWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyWaitlistResponse) Reset() {
+	*x = GetMyWaitlistResponse{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyWaitlistResponse) ProtoMessage() {}
+
+func (x *GetMyWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*GetMyWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMyWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Сообщение для отмены заявки. Удерживаемая запись освобождается
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LeaveWaitlistRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LeaveWaitlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Сообщение для подтверждения удерживаемой записи
+type ConfirmWaitlistHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmWaitlistHoldRequest) Reset() {
+	*x = ConfirmWaitlistHoldRequest{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmWaitlistHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmWaitlistHoldRequest) ProtoMessage() {}
+
+func (x *ConfirmWaitlistHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmWaitlistHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmWaitlistHoldRequest) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConfirmWaitlistHoldRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type ConfirmWaitlistHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int32                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmWaitlistHoldResponse) Reset() {
+	*x = ConfirmWaitlistHoldResponse{}
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmWaitlistHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmWaitlistHoldResponse) ProtoMessage() {}
+
+func (x *ConfirmWaitlistHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_waitlist_waitlist_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmWaitlistHoldResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmWaitlistHoldResponse) Descriptor() ([]byte, []int) {
+	return file_waitlist_waitlist_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfirmWaitlistHoldResponse) GetBookingId() int32 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *ConfirmWaitlistHoldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_waitlist_waitlist_service_proto protoreflect.FileDescriptor
+
+const file_waitlist_waitlist_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fwaitlist/waitlist_service.proto\x12\bwaitlist\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xab\x04\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12$\n" +
+	"\vresource_id\x18\x03 \x01(\x05H\x00R\n" +
+	"resourceId\x88\x01\x01\x12=\n" +
+	"\fwindow_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12)\n" +
+	"\x10duration_minutes\x18\x06 \x01(\x05R\x0fdurationMinutes\x12\x1b\n" +
+	"\tauto_book\x18\a \x01(\bR\bautoBook\x120\n" +
+	"\x06status\x18\b \x01(\x0e2\x18.waitlist.WaitlistStatusR\x06status\x12\"\n" +
+	"\n" +
+	"booking_id\x18\t \x01(\x05H\x01R\tbookingId\x88\x01\x01\x12>\n" +
+	"\n" +
+	"hold_until\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tholdUntil\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_resource_idB\r\n" +
+	"\v_booking_idB\r\n" +
+	"\v_hold_until\"\xcc\x02\n" +
+	"\x13JoinWaitlistRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12$\n" +
+	"\vresource_id\x18\x02 \x01(\x05H\x00R\n" +
+	"resourceId\x88\x01\x01\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12.\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05H\x01R\x0fdurationMinutes\x88\x01\x01\x12\x1b\n" +
+	"\tauto_book\x18\x06 \x01(\bR\bautoBookB\x0e\n" +
+	"\f_resource_idB\x13\n" +
+	"\x11_duration_minutes\"K\n" +
+	"\x14JoinWaitlistResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x16\n" +
+	"\x14GetMyWaitlistRequest\"J\n" +
+	"\x15GetMyWaitlistResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.waitlist.WaitlistEntryR\aentries\"1\n" +
+	"\x14LeaveWaitlistRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\"1\n" +
+	"\x15LeaveWaitlistResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"7\n" +
+	"\x1aConfirmWaitlistHoldRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\"V\n" +
+	"\x1bConfirmWaitlistHoldResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x05R\tbookingId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\xc0\x01\n" +
+	"\x0eWaitlistStatus\x12\x1f\n" +
+	"\x1bWAITLIST_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17WAITLIST_STATUS_WAITING\x10\x01\x12\x18\n" +
+	"\x14WAITLIST_STATUS_HELD\x10\x02\x12\x1a\n" +
+	"\x16WAITLIST_STATUS_BOOKED\x10\x03\x12\x1b\n" +
+	"\x17WAITLIST_STATUS_EXPIRED\x10\x04\x12\x1d\n" +
+	"\x19WAITLIST_STATUS_CANCELLED\x10\x052\xf8\x03\n" +
+	"\x0fWaitlistService\x12j\n" +
+	"\fJoinWaitlist\x12\x1d.waitlist.JoinWaitlistRequest\x1a\x1e.waitlist.JoinWaitlistResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/waitlist\x12m\n" +
+	"\rGetMyWaitlist\x12\x1e.waitlist.GetMyWaitlistRequest\x1a\x1f.waitlist.GetMyWaitlistResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/waitlist/my\x12u\n" +
+	"\rLeaveWaitlist\x12\x1e.waitlist.LeaveWaitlistRequest\x1a\x1f.waitlist.LeaveWaitlistResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/api/v1/waitlist/{entry_id}\x12\x92\x01\n" +
+	"\x13ConfirmWaitlistHold\x12$.waitlist.ConfirmWaitlistHoldRequest\x1a%.waitlist.ConfirmWaitlistHoldResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/waitlist/{entry_id}/confirmB<Z:dormitory-helper-service/generated/proto/waitlist;waitlistb\x06proto3"
+
+var (
+	file_waitlist_waitlist_service_proto_rawDescOnce sync.Once
+	file_waitlist_waitlist_service_proto_rawDescData []byte
+)
+
+func file_waitlist_waitlist_service_proto_rawDescGZIP() []byte {
+	file_waitlist_waitlist_service_proto_rawDescOnce.Do(func() {
+		file_waitlist_waitlist_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_waitlist_waitlist_service_proto_rawDesc), len(file_waitlist_waitlist_service_proto_rawDesc)))
+	})
+	return file_waitlist_waitlist_service_proto_rawDescData
+}
+
+var file_waitlist_waitlist_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_waitlist_waitlist_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_waitlist_waitlist_service_proto_goTypes = []any{
+	(WaitlistStatus)(0),                 // 0: waitlist.WaitlistStatus
+	(*WaitlistEntry)(nil),               // 1: waitlist.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),         // 2: waitlist.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),        // 3: waitlist.JoinWaitlistResponse
+	(*GetMyWaitlistRequest)(nil),        // 4: waitlist.GetMyWaitlistRequest
+	(*GetMyWaitlistResponse)(nil),       // 5: waitlist.GetMyWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),        // 6: waitlist.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),       // 7: waitlist.LeaveWaitlistResponse
+	(*ConfirmWaitlistHoldRequest)(nil),  // 8: waitlist.ConfirmWaitlistHoldRequest
+	(*ConfirmWaitlistHoldResponse)(nil), // 9: waitlist.ConfirmWaitlistHoldResponse
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+}
+var file_waitlist_waitlist_service_proto_depIdxs = []int32{
+	10, // 0: waitlist.WaitlistEntry.window_start:type_name -> google.protobuf.Timestamp
+	10, // 1: waitlist.WaitlistEntry.window_end:type_name -> google.protobuf.Timestamp
+	0,  // 2: waitlist.WaitlistEntry.status:type_name -> waitlist.WaitlistStatus
+	10, // 3: waitlist.WaitlistEntry.hold_until:type_name -> google.protobuf.Timestamp
+	10, // 4: waitlist.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	10, // 5: waitlist.JoinWaitlistRequest.window_start:type_name -> google.protobuf.Timestamp
+	10, // 6: waitlist.JoinWaitlistRequest.window_end:type_name -> google.protobuf.Timestamp
+	1,  // 7: waitlist.GetMyWaitlistResponse.entries:type_name -> waitlist.WaitlistEntry
+	2,  // 8: waitlist.WaitlistService.JoinWaitlist:input_type -> waitlist.JoinWaitlistRequest
+	4,  // 9: waitlist.WaitlistService.GetMyWaitlist:input_type -> waitlist.GetMyWaitlistRequest
+	6,  // 10: waitlist.WaitlistService.LeaveWaitlist:input_type -> waitlist.LeaveWaitlistRequest
+	8,  // 11: waitlist.WaitlistService.ConfirmWaitlistHold:input_type -> waitlist.ConfirmWaitlistHoldRequest
+	3,  // 12: waitlist.WaitlistService.JoinWaitlist:output_type -> waitlist.JoinWaitlistResponse
+	5,  // 13: waitlist.WaitlistService.GetMyWaitlist:output_type -> waitlist.GetMyWaitlistResponse
+	7,  // 14: waitlist.WaitlistService.LeaveWaitlist:output_type -> waitlist.LeaveWaitlistResponse
+	9,  // 15: waitlist.WaitlistService.ConfirmWaitlistHold:output_type -> waitlist.ConfirmWaitlistHoldResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_waitlist_waitlist_service_proto_init() }
+func file_waitlist_waitlist_service_proto_init() {
+	if File_waitlist_waitlist_service_proto != nil {
+		return
+	}
+	file_waitlist_waitlist_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_waitlist_waitlist_service_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_waitlist_waitlist_service_proto_rawDesc), len(file_waitlist_waitlist_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_waitlist_waitlist_service_proto_goTypes,
+		DependencyIndexes: file_waitlist_waitlist_service_proto_depIdxs,
+		EnumInfos:         file_waitlist_waitlist_service_proto_enumTypes,
+		MessageInfos:      file_waitlist_waitlist_service_proto_msgTypes,
+	}.Build()
+	File_waitlist_waitlist_service_proto = out.File
+	file_waitlist_waitlist_service_proto_goTypes = nil
+	file_waitlist_waitlist_service_proto_depIdxs = nil
+}
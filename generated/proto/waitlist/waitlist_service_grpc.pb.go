@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: waitlist/waitlist_service.proto
+
+package waitlist
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WaitlistService_JoinWaitlist_FullMethodName        = "/waitlist.WaitlistService/JoinWaitlist"
+	WaitlistService_GetMyWaitlist_FullMethodName       = "/waitlist.WaitlistService/GetMyWaitlist"
+	WaitlistService_LeaveWaitlist_FullMethodName       = "/waitlist.WaitlistService/LeaveWaitlist"
+	WaitlistService_ConfirmWaitlistHold_FullMethodName = "/waitlist.WaitlistService/ConfirmWaitlistHold"
+)
+
+// WaitlistServiceClient is the client API for WaitlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WaitlistServiceClient interface {
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	GetMyWaitlist(ctx context.Context, in *GetMyWaitlistRequest, opts ...grpc.CallOption) (*GetMyWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	ConfirmWaitlistHold(ctx context.Context, in *ConfirmWaitlistHoldRequest, opts ...grpc.CallOption) (*ConfirmWaitlistHoldResponse, error)
+}
+
+type waitlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWaitlistServiceClient(cc grpc.ClientConnInterface) WaitlistServiceClient {
+	return &waitlistServiceClient{cc}
+}
+
+func (c *waitlistServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, WaitlistService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waitlistServiceClient) GetMyWaitlist(ctx context.Context, in *GetMyWaitlistRequest, opts ...grpc.CallOption) (*GetMyWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyWaitlistResponse)
+	err := c.cc.Invoke(ctx, WaitlistService_GetMyWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waitlistServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, WaitlistService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waitlistServiceClient) ConfirmWaitlistHold(ctx context.Context, in *ConfirmWaitlistHoldRequest, opts ...grpc.CallOption) (*ConfirmWaitlistHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmWaitlistHoldResponse)
+	err := c.cc.Invoke(ctx, WaitlistService_ConfirmWaitlistHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WaitlistServiceServer is the server API for WaitlistService service.
+// All implementations must embed UnimplementedWaitlistServiceServer
+// for forward compatibility.
+type WaitlistServiceServer interface {
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	GetMyWaitlist(context.Context, *GetMyWaitlistRequest) (*GetMyWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	ConfirmWaitlistHold(context.Context, *ConfirmWaitlistHoldRequest) (*ConfirmWaitlistHoldResponse, error)
+	mustEmbedUnimplementedWaitlistServiceServer()
+}
+
+// UnimplementedWaitlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWaitlistServiceServer struct{}
+
+func (UnimplementedWaitlistServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedWaitlistServiceServer) GetMyWaitlist(context.Context, *GetMyWaitlistRequest) (*GetMyWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyWaitlist not implemented")
+}
+func (UnimplementedWaitlistServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedWaitlistServiceServer) ConfirmWaitlistHold(context.Context, *ConfirmWaitlistHoldRequest) (*ConfirmWaitlistHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmWaitlistHold not implemented")
+}
+func (UnimplementedWaitlistServiceServer) mustEmbedUnimplementedWaitlistServiceServer() {}
+func (UnimplementedWaitlistServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWaitlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WaitlistServiceServer will
+// result in compilation errors.
+type UnsafeWaitlistServiceServer interface {
+	mustEmbedUnimplementedWaitlistServiceServer()
+}
+
+func RegisterWaitlistServiceServer(s grpc.ServiceRegistrar, srv WaitlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWaitlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WaitlistService_ServiceDesc, srv)
+}
+
+func _WaitlistService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaitlistServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WaitlistService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaitlistServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaitlistService_GetMyWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaitlistServiceServer).GetMyWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WaitlistService_GetMyWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaitlistServiceServer).GetMyWaitlist(ctx, req.(*GetMyWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaitlistService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaitlistServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WaitlistService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaitlistServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaitlistService_ConfirmWaitlistHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmWaitlistHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaitlistServiceServer).ConfirmWaitlistHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WaitlistService_ConfirmWaitlistHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaitlistServiceServer).ConfirmWaitlistHold(ctx, req.(*ConfirmWaitlistHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WaitlistService_ServiceDesc is the grpc.ServiceDesc for WaitlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WaitlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "waitlist.WaitlistService",
+	HandlerType: (*WaitlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _WaitlistService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "GetMyWaitlist",
+			Handler:    _WaitlistService_GetMyWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _WaitlistService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "ConfirmWaitlistHold",
+			Handler:    _WaitlistService_ConfirmWaitlistHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "waitlist/waitlist_service.proto",
+}
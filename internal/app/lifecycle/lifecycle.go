@@ -0,0 +1,74 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// StopFunc останавливает компонент. Должна вернуться до истечения ctx
+type StopFunc func(ctx context.Context) error
+
+type hook struct {
+	name string
+	stop StopFunc
+}
+
+// Manager останавливает компоненты приложения в порядке, обратном запуску:
+// сначала серверы перестают принимать запросы и дожидаются текущих,
+// затем останавливаются фоновые задачи и закрывается пул соединений
+type Manager struct {
+	timeout time.Duration
+
+	mu    sync.Mutex
+	hooks []hook
+}
+
+func NewManager(timeout time.Duration) *Manager {
+	return &Manager{
+		timeout: timeout,
+	}
+}
+
+// OnStop регистрирует функцию остановки компонента.
+// Вызывать сразу после успешного запуска компонента
+func (m *Manager) OnStop(name string, stop StopFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.hooks = append(m.hooks, hook{name: name, stop: stop})
+}
+
+// Shutdown вызывает зарегистрированные функции в обратном порядке.
+// На всю остановку отводится timeout, ошибки всех компонентов объединяются
+func (m *Manager) Shutdown() error {
+	m.mu.Lock()
+	hooks := m.hooks
+	m.hooks = nil
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		h := hooks[i]
+		slog.Info("stopping component", slog.String("component", h.name))
+		if err := h.stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", h.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SignalContext возвращает контекст, который отменяется при SIGINT или SIGTERM
+func SignalContext(parent context.Context) (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+}
@@ -2,118 +2,442 @@ package serviceApp
 
 import (
 	"context"
+	"dormitory-helper-service/internal/app/lifecycle"
 	"dormitory-helper-service/internal/config"
+	adminServer "dormitory-helper-service/internal/grpc/admin"
+	bookingServer "dormitory-helper-service/internal/grpc/booking"
 	kitchenServer "dormitory-helper-service/internal/grpc/kitchen"
 	laundryServer "dormitory-helper-service/internal/grpc/laundry"
 	userServer "dormitory-helper-service/internal/grpc/user"
+	waitlistServer "dormitory-helper-service/internal/grpc/waitlist"
+	"dormitory-helper-service/internal/health"
+	"dormitory-helper-service/internal/logging"
+	"dormitory-helper-service/internal/metrics"
+	"dormitory-helper-service/internal/migrator"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
 	laundryRepository "dormitory-helper-service/internal/repository/laundry"
 	userRepository "dormitory-helper-service/internal/repository/user"
+	waitlistRepository "dormitory-helper-service/internal/repository/waitlist"
+	"dormitory-helper-service/internal/scheduler"
+	adminService "dormitory-helper-service/internal/service/admin"
+	bookingService "dormitory-helper-service/internal/service/booking"
+	kitchenService "dormitory-helper-service/internal/service/kitchen"
 	laundryService "dormitory-helper-service/internal/service/laundry"
 	userService "dormitory-helper-service/internal/service/user"
+	"dormitory-helper-service/internal/tracing"
+	grpcUtils "dormitory-helper-service/internal/utils/grpc"
+	jwtUtils "dormitory-helper-service/internal/utils/jwt"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	adminProto "dormitory-helper-service/generated/proto/admin"
+	bookingProto "dormitory-helper-service/generated/proto/booking"
 	kitchenProto "dormitory-helper-service/generated/proto/kitchen"
 	laundryProto "dormitory-helper-service/generated/proto/laundry"
 	userProto "dormitory-helper-service/generated/proto/user"
+	waitlistProto "dormitory-helper-service/generated/proto/waitlist"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
-func Run() {
-	ctx := context.Background()
+// Run запускает приложение и блокируется до отмены ctx или ошибки одного из
+// серверов, после чего останавливает компоненты в обратном порядке
+func Run(ctx context.Context, cfg *config.Config) (err error) {
+	if err := logging.Setup(os.Stdout, cfg.LogConfig.Format, cfg.LogConfig.Level); err != nil {
+		return fmt.Errorf("failed to set up logging: %w", err)
+	}
 
-	// Инициализация конфига
-	cfg := config.NewConfig()
-	cfg.Load()
+	lc := lifecycle.NewManager(cfg.ServerConfig.ShutdownTimeout)
+	defer func() {
+		if shutdownErr := lc.Shutdown(); shutdownErr != nil {
+			err = errors.Join(err, shutdownErr)
+		}
+	}()
+
+	// Трассировка останавливается последней, чтобы отправить спаны остановки
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+		ServiceName:  "dormitory-helper-service",
+		Exporter:     cfg.TracingConfig.Exporter,
+		OTLPEndpoint: cfg.TracingConfig.OTLPEndpoint,
+		OTLPInsecure: cfg.TracingConfig.OTLPInsecure,
+		SampleRatio:  cfg.TracingConfig.SampleRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	lc.OnStop("tracing", shutdownTracing)
 
 	// Инициализация базы данных
-	dbURL := fmt.Sprintf(
-		"%s://%s:%s@%s:%d/%s?sslmode=disable",
-		cfg.DatabaseConfig.Driver,
-		cfg.DatabaseConfig.User,
-		cfg.DatabaseConfig.Password,
-		cfg.DatabaseConfig.Host,
-		cfg.DatabaseConfig.Port,
-		cfg.DatabaseConfig.DBName,
-	)
-
-	poolConfig, err := pgxpool.ParseConfig(dbURL)
+	poolConfig, err := pgxpool.ParseConfig(cfg.DatabaseConfig.URL())
 	if err != nil {
-		log.Fatalf("failed to parse database config: %v", err)
+		return fmt.Errorf("failed to parse database config: %w", err)
 	}
 
 	// Настройка пула соединений
-	poolConfig.MaxConns = 25
-	poolConfig.MinConns = 5
+	poolConfig.MaxConns = cfg.DatabaseConfig.MaxConns
+	poolConfig.MinConns = cfg.DatabaseConfig.MinConns
+	poolConfig.ConnConfig.Tracer = tracing.NewPgxTracer()
 
 	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
-		log.Fatalf("failed to create connection pool: %v", err)
+		return fmt.Errorf("failed to create connection pool: %w", err)
 	}
-	defer db.Close()
+	lc.OnStop("database pool", func(ctx context.Context) error {
+		db.Close()
+		return nil
+	})
 
 	// Проверка подключения к базе данных
 	if err := db.Ping(ctx); err != nil {
-		log.Fatalf("failed to ping database: %v", err)
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	slog.Info("successfully connected to database")
+
+	if err := metrics.RegisterPool(db); err != nil {
+		return fmt.Errorf("failed to register pool metrics: %w", err)
+	}
+
+	// Миграции. Сервис не запускается на устаревшей схеме. Мигратор остается открытым
+	// для проверки готовности и закрывается раньше пула
+	sqlDB := stdlib.OpenDBFromPool(db)
+	lc.OnStop("migrator", func(ctx context.Context) error {
+		return sqlDB.Close()
+	})
+
+	schema, err := migrator.New(sqlDB)
+	if err != nil {
+		return err
 	}
 
-	log.Println("Successfully connected to database")
+	if cfg.DatabaseConfig.AutoMigrate {
+		if err := schema.Up(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := schema.CheckSchema(ctx); err != nil {
+		return fmt.Errorf("%w: run \"migrate up\" or start with -migrate", err)
+	}
+
+	location, err := cfg.BookingConfig.Location()
+	if err != nil {
+		return fmt.Errorf("failed to load booking time zone: %w", err)
+	}
 
 	// Инициализация репозиториев
 	userRepo := userRepository.NewRepository()
+	bookingRepo := bookingRepository.NewRepository()
 	laundryRepo := laundryRepository.NewRepository()
+	waitlistRepo := waitlistRepository.NewRepository()
 
 	// Инициализация сервисов
-	userServ := userService.NewService(userRepo, db, cfg.ServerConfig.JWTSecretKey)
-	laundryServ := laundryService.NewService(laundryRepo, db)
+	userServ := userService.NewService(userRepo, db, cfg.ServerConfig.JWTSecretKey, cfg.ServerConfig.JWTTTL, cfg.UserConfig.TTL)
+	bookingServ := bookingService.NewService(bookingRepo, waitlistRepo, db, cfg.BookingConfig.MaxAdvance, location, cfg.BookingConfig.WaitlistHold,
+		bookingService.NoShowPolicy{
+			Limit:  cfg.BookingConfig.NoShowLimit,
+			Window: cfg.BookingConfig.NoShowWindow,
+			Ban:    cfg.BookingConfig.NoShowBan,
+		}, cfg.BookingConfig.SlotGrid)
+	laundryServ := laundryService.NewService(bookingServ, laundryRepo, db)
+	kitchenServ := kitchenService.NewService(bookingServ)
+	adminServ := adminService.NewService(bookingRepo, userServ, bookingServ, db)
+
+	if err := userServ.EnsureAdmins(ctx, cfg.UserConfig.AdminIDs); err != nil {
+		return err
+	}
+
+	// Фоновые задачи
+	jobs := scheduler.New(db)
+	jobs.Add(scheduler.Job{
+		Name:     "cleanup-expired-users",
+		Interval: cfg.SchedulerConfig.CleanupInterval,
+		Jitter:   cfg.SchedulerConfig.Jitter,
+		Run: func(ctx context.Context) error {
+			deleted, err := userServ.CleanupExpiredUsers(ctx)
+			if err != nil {
+				return err
+			}
+			if deleted > 0 {
+				slog.InfoContext(ctx, "deleted expired users", slog.Int("count", deleted))
+			}
+			return nil
+		},
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "purge-past-bookings",
+		Interval: cfg.SchedulerConfig.PurgeInterval,
+		Jitter:   cfg.SchedulerConfig.Jitter,
+		Run: func(ctx context.Context) error {
+			deleted, err := bookingServ.PurgePastBookings(ctx, cfg.SchedulerConfig.BookingRetention)
+			if err != nil {
+				return err
+			}
+			if deleted > 0 {
+				slog.InfoContext(ctx, "purged past bookings", slog.Int("count", deleted))
+			}
+			return nil
+		},
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "expire-waitlist",
+		Interval: cfg.SchedulerConfig.WaitlistInterval,
+		Jitter:   cfg.SchedulerConfig.Jitter,
+		Run: func(ctx context.Context) error {
+			expired, err := bookingServ.ExpireWaitlist(ctx)
+			if err != nil {
+				return err
+			}
+			if expired > 0 {
+				slog.InfoContext(ctx, "expired waitlist entries", slog.Int("count", expired))
+			}
+			return nil
+		},
+	})
+	jobs.Add(scheduler.Job{
+		Name:     "release-no-shows",
+		Interval: cfg.SchedulerConfig.NoShowInterval,
+		Jitter:   cfg.SchedulerConfig.Jitter,
+		Run: func(ctx context.Context) error {
+			released, err := bookingServ.ReleaseNoShows(ctx)
+			if err != nil {
+				return err
+			}
+			if released > 0 {
+				slog.InfoContext(ctx, "released no-show bookings", slog.Int("count", released))
+			}
+			return nil
+		},
+	})
+	jobs.Start(ctx)
+	lc.OnStop("scheduler", jobs.Stop)
+
+	// Проверки живости и готовности
+	checker := health.NewChecker()
+	checker.AddLivenessCheck("scheduler", jobs.Check)
+	checker.AddReadinessCheck("database", health.DatabaseCheck(db))
+	checker.AddReadinessCheck("migrations", schema.CheckSchema)
+	go checker.Watch(ctx, 5*time.Second)
+
+	// Инициализация gRPC серверов
+	userGrpcServer := userServer.NewServer(userServ)
+	laundryGrpcServer := laundryServer.NewServer(laundryServ)
+	kitchenGrpcServer := kitchenServer.NewServer(kitchenServ)
+	bookingGrpcServer := bookingServer.NewServer(bookingServ)
+	adminGrpcServer := adminServer.NewServer(adminServ, jobs)
+	waitlistGrpcServer := waitlistServer.NewServer(bookingServ)
+
+	// gRPC сервер. Gateway ходит в него по сети, поэтому интерцепторы
+	// общие для нативных gRPC клиентов и HTTP запросов. Logging и Metrics оборачивают
+	// Recovery, Auth и RequireRole, чтобы видеть их коды, в том числе Internal после паники
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			grpcUtils.RequestIDUnaryInterceptor,
+			grpcUtils.LoggingUnaryInterceptor,
+			grpcUtils.MetricsUnaryInterceptor,
+			grpcUtils.RecoveryUnaryInterceptor,
+			grpcUtils.AuthUnaryInterceptor(
+				cfg.ServerConfig.JWTSecretKey,
+				userProto.UserService_CheckAuthentication_FullMethodName,
+			),
+			grpcUtils.RequireRoleUnaryInterceptor(
+				"/"+adminProto.AdminService_ServiceDesc.ServiceName+"/",
+				jwtUtils.RoleAdmin,
+				userServ,
+			),
+		),
+	)
+
+	userProto.RegisterUserServiceServer(grpcServer, userGrpcServer)
+	laundryProto.RegisterLaundryServiceServer(grpcServer, laundryGrpcServer)
+	kitchenProto.RegisterKitchenServiceServer(grpcServer, kitchenGrpcServer)
+	bookingProto.RegisterBookingServiceServer(grpcServer, bookingGrpcServer)
+	adminProto.RegisterAdminServiceServer(grpcServer, adminGrpcServer)
+	waitlistProto.RegisterWaitlistServiceServer(grpcServer, waitlistGrpcServer)
+	healthpb.RegisterHealthServer(grpcServer, checker.GRPCServer())
+	reflection.Register(grpcServer)
+
+	grpcAddress := net.JoinHostPort(cfg.ServerConfig.Host, strconv.Itoa(cfg.ServerConfig.GRPCPort))
+	grpcListener, err := net.Listen("tcp", grpcAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", grpcAddress, err)
+	}
+
+	// Ошибки серверов, из-за которых приложение должно остановиться
+	serveErr := make(chan error, 2)
+
+	go func() {
+		slog.Info("starting gRPC server", slog.String("address", grpcAddress))
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			serveErr <- fmt.Errorf("gRPC server failed: %w", err)
+		}
+	}()
+	lc.OnStop("gRPC server", func(ctx context.Context) error {
+		return stopGRPCServer(ctx, grpcServer)
+	})
 
 	// Создание HTTP gateway с grpc-gateway
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
 	)
 
-	// Инициализация gRPC серверов
-	userGrpcServer := userServer.NewServer(userServ)
-	laundryGrpcServer := laundryServer.NewServer(laundryServ, cfg.ServerConfig.JWTSecretKey)
-	kitchenGrpcServer := kitchenServer.NewServer(laundryServ, cfg.ServerConfig.JWTSecretKey)
+	grpcConn, err := grpc.NewClient(
+		gatewayDialAddress(cfg.ServerConfig.Host, cfg.ServerConfig.GRPCPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create gRPC client for gateway: %w", err)
+	}
+	lc.OnStop("gateway connection", func(ctx context.Context) error {
+		return grpcConn.Close()
+	})
+
+	// Регистрация сервисов в gateway через gRPC соединение
+	err = userProto.RegisterUserServiceHandler(ctx, mux, grpcConn)
+	if err != nil {
+		return fmt.Errorf("failed to register user service handler: %w", err)
+	}
+
+	err = laundryProto.RegisterLaundryServiceHandler(ctx, mux, grpcConn)
+	if err != nil {
+		return fmt.Errorf("failed to register laundry service handler: %w", err)
+	}
+
+	err = kitchenProto.RegisterKitchenServiceHandler(ctx, mux, grpcConn)
+	if err != nil {
+		return fmt.Errorf("failed to register kitchen service handler: %w", err)
+	}
+
+	err = bookingProto.RegisterBookingServiceHandler(ctx, mux, grpcConn)
+	if err != nil {
+		return fmt.Errorf("failed to register booking service handler: %w", err)
+	}
+
+	err = adminProto.RegisterAdminServiceHandler(ctx, mux, grpcConn)
+	if err != nil {
+		return fmt.Errorf("failed to register admin service handler: %w", err)
+	}
+
+	err = waitlistProto.RegisterWaitlistServiceHandler(ctx, mux, grpcConn)
+	if err != nil {
+		return fmt.Errorf("failed to register waitlist service handler: %w", err)
+	}
 
-	// Регистрация сервисов напрямую в gateway (in-process)
-	err = userProto.RegisterUserServiceHandlerServer(ctx, mux, userGrpcServer)
+	err = mux.HandlePath(http.MethodGet, "/healthz", checker.LivenessHandler)
 	if err != nil {
-		log.Fatalf("Failed to register user service handler: %v", err)
+		return fmt.Errorf("failed to register liveness handler: %w", err)
 	}
 
-	err = laundryProto.RegisterLaundryServiceHandlerServer(ctx, mux, laundryGrpcServer)
+	err = mux.HandlePath(http.MethodGet, "/readyz", checker.ReadinessHandler)
 	if err != nil {
-		log.Fatalf("Failed to register laundry service handler: %v", err)
+		return fmt.Errorf("failed to register readiness handler: %w", err)
 	}
 
-	err = kitchenProto.RegisterKitchenServiceHandlerServer(ctx, mux, kitchenGrpcServer)
+	err = mux.HandlePath(http.MethodGet, "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		metrics.Handler().ServeHTTP(w, r)
+	})
 	if err != nil {
-		log.Fatalf("Failed to register kitchen service handler: %v", err)
+		return fmt.Errorf("failed to register metrics handler: %w", err)
 	}
 
 	// HTTP сервер с middleware
-	httpAddress := ":8081"
-	handler := corsMiddleware(loggingMiddleware(mux))
+	httpAddress := net.JoinHostPort(cfg.ServerConfig.Host, strconv.Itoa(cfg.ServerConfig.Port))
+	handler := corsMiddleware(cfg.ServerConfig.CORSAllowedOrigins, requestIDMiddleware(loggingMiddleware(metricsMiddleware(mux))))
+	handler = otelhttp.NewHandler(handler, "http",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + routeLabel(r.URL.Path)
+		}),
+	)
 
 	httpServer := &http.Server{
 		Addr:         httpAddress,
 		Handler:      handler,
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		IdleTimeout:  60 * time.Second,
+		ReadTimeout:  cfg.ServerConfig.ReadTimeout,
+		WriteTimeout: cfg.ServerConfig.WriteTimeout,
+		IdleTimeout:  cfg.ServerConfig.IdleTimeout,
 	}
 
-	log.Printf("Starting HTTP server on %s", httpAddress)
+	httpListener, err := net.Listen("tcp", httpAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", httpAddress, err)
+	}
+
+	go func() {
+		slog.Info("starting HTTP server", slog.String("address", httpAddress))
+		if err := httpServer.Serve(httpListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("HTTP server failed: %w", err)
+		}
+	}()
+	lc.OnStop("HTTP server", httpServer.Shutdown)
+
+	// Останавливается первым: балансировщику дается ShutdownDelay, чтобы заметить
+	// снятие готовности и перестать направлять трафик, и только потом серверы
+	// перестают принимать запросы и дорабатывают текущие
+	lc.OnStop("readiness", func(ctx context.Context) error {
+		checker.Shutdown()
+
+		delay := time.NewTimer(cfg.ServerConfig.ShutdownDelay)
+		defer delay.Stop()
+		select {
+		case <-delay.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
+	select {
+	case <-ctx.Done():
+		slog.Info("shutting down")
+		return nil
+	case err := <-serveErr:
+		return err
+	}
+}
+
+// stopGRPCServer дожидается завершения текущих вызовов. Если ctx истекает
+// раньше, оставшиеся вызовы прерываются
+func stopGRPCServer(ctx context.Context, server *grpc.Server) error {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return ctx.Err()
+	}
+}
 
-	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		log.Fatalf("Failed to start HTTP server: %v", err)
+// gatewayDialAddress возвращает адрес, по которому gateway подключается к gRPC серверу.
+// Если сервер слушает все интерфейсы, подключаемся через localhost
+func gatewayDialAddress(host string, port int) string {
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
 	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
 }
 
 // customHeaderMatcher определяет какие заголовки будут переданы в gRPC контекст
@@ -126,13 +450,24 @@ func customHeaderMatcher(key string) (string, bool) {
 	}
 }
 
-// corsMiddleware добавляет CORS заголовки
-func corsMiddleware(next http.Handler) http.Handler {
+// corsMiddleware добавляет CORS заголовки для разрешенных источников.
+// "*" в allowedOrigins разрешает любой источник
+func corsMiddleware(allowedOrigins []string, next http.Handler) http.Handler {
+	allowAny := slices.Contains(allowedOrigins, "*")
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Устанавливаем CORS заголовки
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		origin := r.Header.Get("Origin")
+		switch {
+		case allowAny:
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		case origin != "" && slices.Contains(allowedOrigins, origin):
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+		}
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-Request-Id")
+		w.Header().Set("Access-Control-Expose-Headers", logging.RequestIDHeader)
 		w.Header().Set("Access-Control-Max-Age", "86400")
 
 		// Обработка preflight запросов
@@ -145,13 +480,30 @@ func corsMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// requestIDMiddleware берет X-Request-Id из запроса или генерирует новый,
+// возвращает его в ответе и передает дальше в gRPC через заголовок
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := logging.RequestIDOrNew(r.Header.Get(logging.RequestIDHeader))
+
+		r.Header.Set(logging.RequestIDHeader, requestID)
+		w.Header().Set(logging.RequestIDHeader, requestID)
+
+		ctx := logging.NewRequestContext(r.Context(), requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 // loggingMiddleware логирует все HTTP запросы
 func loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
 		// Логируем запрос
-		log.Printf("Started %s %s", r.Method, r.RequestURI)
+		slog.DebugContext(r.Context(), "http request started",
+			slog.String("method", r.Method),
+			slog.String("uri", r.RequestURI),
+		)
 
 		// Создаем wrapper для ResponseWriter чтобы перехватить status code
 		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -160,11 +512,53 @@ func loggingMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(wrapped, r)
 
 		// Логируем ответ
-		duration := time.Since(start)
-		log.Printf("Completed %s %s with %d in %v", r.Method, r.RequestURI, wrapped.statusCode, duration)
+		slog.InfoContext(r.Context(), "http request completed",
+			slog.String("method", r.Method),
+			slog.String("uri", r.RequestURI),
+			slog.Int("status", wrapped.statusCode),
+			slog.Duration("duration", time.Since(start)),
+		)
+	})
+}
+
+// metricsMiddleware записывает длительность и статус HTTP запросов
+func metricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(wrapped, r)
+
+		metrics.HTTPRequestDuration.
+			WithLabelValues(r.Method, routeLabel(r.URL.Path), strconv.Itoa(wrapped.statusCode)).
+			Observe(time.Since(start).Seconds())
 	})
 }
 
+// routeLabel убирает из пути идентификаторы, чтобы число значений метки
+// не росло с количеством записей и пользователей
+func routeLabel(path string) string {
+	switch path {
+	case "/healthz", "/readyz", "/metrics":
+		return path
+	}
+	if !strings.HasPrefix(path, "/api/") {
+		return "other"
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = "{id}"
+		}
+		// Тип помещения приходит от клиента, поэтому тоже заменяется
+		if i > 0 && segments[i-1] == "facilities" {
+			segments[i] = "{resource_type}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
 // responseWriter wrapper для перехвата status code
 type responseWriter struct {
 	http.ResponseWriter
@@ -1,91 +1,202 @@
 package config
 
 import (
-	"fmt"
-	"os"
+	"net"
+	"net/url"
 	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	ServerConfig   ServerConfig
-	DatabaseConfig DatabaseConfig
+	ServerConfig    ServerConfig    `yaml:"server"`
+	DatabaseConfig  DatabaseConfig  `yaml:"database"`
+	SchedulerConfig SchedulerConfig `yaml:"scheduler"`
+	LogConfig       LogConfig       `yaml:"log"`
+	TracingConfig   TracingConfig   `yaml:"tracing"`
+	UserConfig      UserConfig      `yaml:"user"`
+	BookingConfig   BookingConfig   `yaml:"booking"`
 }
 
 type ServerConfig struct {
-	Host         string
-	Port         int
-	JWTSecretKey []byte
+	Host         string `yaml:"host"`
+	Port         int    `yaml:"port"`
+	GRPCPort     int    `yaml:"grpc_port"`
+	JWTSecretKey Secret `yaml:"jwt_secret_key"`
+	// JWTTTL срок действия выдаваемых токенов
+	JWTTTL time.Duration `yaml:"jwt_ttl"`
+	// ShutdownTimeout время на завершение текущих запросов при остановке
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// ShutdownDelay пауза между снятием готовности и остановкой серверов, чтобы
+	// балансировщик успел убрать экземпляр. Входит в ShutdownTimeout
+	ShutdownDelay time.Duration `yaml:"shutdown_delay"`
+	ReadTimeout   time.Duration `yaml:"read_timeout"`
+	WriteTimeout  time.Duration `yaml:"write_timeout"`
+	IdleTimeout   time.Duration `yaml:"idle_timeout"`
+	// CORSAllowedOrigins источники, которым разрешены запросы из браузера. "*" - любые
+	CORSAllowedOrigins []string `yaml:"cors_allowed_origins"`
 }
 
 type DatabaseConfig struct {
-	Host     string
-	Port     int
-	DBName   string
-	User     string
-	Password string
-	Driver   string
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	DBName   string `yaml:"name"`
+	User     string `yaml:"user"`
+	Password Secret `yaml:"password"`
+	Driver   string `yaml:"driver"`
+	SSLMode  string `yaml:"sslmode"`
+	MaxConns int32  `yaml:"max_conns"`
+	MinConns int32  `yaml:"min_conns"`
+	// AutoMigrate применять встроенные миграции при запуске
+	AutoMigrate bool `yaml:"auto_migrate"`
 }
 
-func NewConfig() *Config {
-	return &Config{}
+// URL строка подключения к базе данных для pgx
+func (c DatabaseConfig) URL() string {
+	u := url.URL{
+		Scheme:   c.Driver,
+		User:     url.UserPassword(c.User, string(c.Password)),
+		Host:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:     c.DBName,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
 }
 
-func (c *Config) Load() {
-	// Загрузка конфигурации сервера
-	c.ServerConfig.Host = os.Getenv("SERVER_HOST")
-	if c.ServerConfig.Host == "" {
-		panic("SERVER_HOST environment variable is required")
-	}
+// SchedulerConfig настройки фоновых задач
+type SchedulerConfig struct {
+	// CleanupInterval период удаления пользователей с истекшим временем жизни
+	CleanupInterval time.Duration `yaml:"cleanup_interval"`
+	// PurgeInterval период удаления прошедших записей
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+	// Jitter максимальная случайная добавка к периоду запуска
+	Jitter time.Duration `yaml:"jitter"`
+	// BookingRetention сколько хранить записи после их окончания
+	BookingRetention time.Duration `yaml:"booking_retention"`
+	// WaitlistInterval период освобождения неподтвержденных записей из листа ожидания
+	WaitlistInterval time.Duration `yaml:"waitlist_interval"`
+	// NoShowInterval период освобождения записей, на которые не отметились вовремя
+	NoShowInterval time.Duration `yaml:"no_show_interval"`
+}
 
-	portStr := os.Getenv("SERVER_PORT")
-	if portStr == "" {
-		panic("SERVER_PORT environment variable is required")
-	}
-	port, err := strconv.Atoi(portStr)
-	if err != nil {
-		panic(fmt.Sprintf("invalid SERVER_PORT: %v", err))
-	}
-	c.ServerConfig.Port = port
+// LogConfig настройки логирования
+type LogConfig struct {
+	// Format json или text
+	Format string `yaml:"format"`
+	// Level debug, info, warn или error
+	Level string `yaml:"level"`
+}
 
-	jwtSecret := os.Getenv("JWT_SECRET_KEY")
-	if jwtSecret == "" {
-		panic("JWT_SECRET_KEY environment variable is required")
-	}
-	c.ServerConfig.JWTSecretKey = []byte(jwtSecret)
+// TracingConfig настройки OpenTelemetry
+type TracingConfig struct {
+	// Exporter none, stdout или otlp
+	Exporter     string `yaml:"exporter"`
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+	OTLPInsecure bool   `yaml:"otlp_insecure"`
+	// SampleRatio доля трассируемых запросов от 0 до 1
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
 
-	// Загрузка конфигурации базы данных
-	c.DatabaseConfig.Host = os.Getenv("DATABASE_HOST")
-	if c.DatabaseConfig.Host == "" {
-		panic("DATABASE_HOST environment variable is required")
-	}
+// UserConfig настройки анонимных пользователей
+type UserConfig struct {
+	// TTL время жизни пользователя без записей
+	TTL time.Duration `yaml:"ttl"`
+	// AdminIDs пользователи, которым при запуске назначается роль администратора.
+	// Так назначается первый администратор, остальных он назначает через AdminService
+	AdminIDs []int `yaml:"admin_ids"`
+}
 
-	dbPortStr := os.Getenv("DATABASE_PORT")
-	if dbPortStr == "" {
-		panic("DATABASE_PORT environment variable is required")
-	}
-	dbPort, err := strconv.Atoi(dbPortStr)
-	if err != nil {
-		panic(fmt.Sprintf("invalid DATABASE_PORT: %v", err))
-	}
-	c.DatabaseConfig.Port = dbPort
+// BookingConfig общие ограничения бронирования
+type BookingConfig struct {
+	// MaxAdvance насколько далеко вперед можно записаться. 0 - без ограничения.
+	// Для типа ресурса переопределяется в таблице booking_quotas
+	MaxAdvance time.Duration `yaml:"max_advance"`
+	// TimeZone часовой пояс общежития (IANA, например Europe/Moscow).
+	// В нем проверяются правила записи и считаются границы дней
+	TimeZone string `yaml:"time_zone"`
+	// WaitlistHold сколько удерживается запись, выданная из листа ожидания, до подтверждения
+	WaitlistHold time.Duration `yaml:"waitlist_hold"`
+	// NoShowLimit после скольких неявок за NoShowWindow пользователю запрещается
+	// бронирование на NoShowBan. 0 - неявки не ограничивают бронирование
+	NoShowLimit  int           `yaml:"no_show_limit"`
+	NoShowWindow time.Duration `yaml:"no_show_window"`
+	NoShowBan    time.Duration `yaml:"no_show_ban"`
+	// SlotGrid шаг, с которым ищутся свободные слоты на ресурсах без slot_step_minutes
+	SlotGrid time.Duration `yaml:"slot_grid"`
+}
 
-	c.DatabaseConfig.DBName = os.Getenv("DATABASE_NAME")
-	if c.DatabaseConfig.DBName == "" {
-		panic("DATABASE_NAME environment variable is required")
-	}
+// Location загружает часовой пояс общежития
+func (c BookingConfig) Location() (*time.Location, error) {
+	return time.LoadLocation(c.TimeZone)
+}
 
-	c.DatabaseConfig.User = os.Getenv("DATABASE_USER")
-	if c.DatabaseConfig.User == "" {
-		panic("DATABASE_USER environment variable is required")
-	}
+// Secret значение, которое не выводится в логи
+type Secret []byte
 
-	c.DatabaseConfig.Password = os.Getenv("DATABASE_PASSWORD")
-	if c.DatabaseConfig.Password == "" {
-		panic("DATABASE_PASSWORD environment variable is required")
+func (s Secret) String() string {
+	if len(s) == 0 {
+		return ""
 	}
+	return "***"
+}
+
+func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
+	*s = Secret(value.Value)
+	return nil
+}
 
-	c.DatabaseConfig.Driver = os.Getenv("DRIVER")
-	if c.DatabaseConfig.Driver == "" {
-		panic("DRIVER environment variable is required")
+// Defaults возвращает конфигурацию по умолчанию
+func Defaults() *Config {
+	return &Config{
+		ServerConfig: ServerConfig{
+			Host:               "0.0.0.0",
+			Port:               8081,
+			GRPCPort:           50051,
+			JWTTTL:             7 * 24 * time.Hour,
+			ShutdownTimeout:    30 * time.Second,
+			ShutdownDelay:      5 * time.Second,
+			ReadTimeout:        15 * time.Second,
+			WriteTimeout:       15 * time.Second,
+			IdleTimeout:        60 * time.Second,
+			CORSAllowedOrigins: []string{"*"},
+		},
+		DatabaseConfig: DatabaseConfig{
+			Host:     "localhost",
+			Port:     5432,
+			Driver:   "postgres",
+			SSLMode:  "disable",
+			MaxConns: 25,
+			MinConns: 5,
+		},
+		SchedulerConfig: SchedulerConfig{
+			CleanupInterval:  5 * time.Minute,
+			PurgeInterval:    time.Hour,
+			Jitter:           30 * time.Second,
+			BookingRetention: 30 * 24 * time.Hour,
+			WaitlistInterval: time.Minute,
+			NoShowInterval:   time.Minute,
+		},
+		LogConfig: LogConfig{
+			Format: "json",
+			Level:  "info",
+		},
+		TracingConfig: TracingConfig{
+			Exporter:     "none",
+			OTLPEndpoint: "localhost:4317",
+			SampleRatio:  1,
+		},
+		UserConfig: UserConfig{
+			TTL: 7 * 24 * time.Hour,
+		},
+		BookingConfig: BookingConfig{
+			MaxAdvance:   30 * 24 * time.Hour,
+			TimeZone:     "UTC",
+			WaitlistHold: 15 * time.Minute,
+			NoShowLimit:  3,
+			NoShowWindow: 30 * 24 * time.Hour,
+			NoShowBan:    7 * 24 * time.Hour,
+			SlotGrid:     30 * time.Minute,
+		},
 	}
 }
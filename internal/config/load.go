@@ -0,0 +1,291 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Load собирает конфигурацию (см. Parse) и проверяет ее целиком.
+// Возвращает все найденные ошибки сразу
+func Load(args []string) (*Config, error) {
+	cfg, err := Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Parse собирает конфигурацию из слоев, каждый следующий переопределяет предыдущий:
+// значения по умолчанию, YAML файл (-config или CONFIG_FILE), .env файл,
+// переменные окружения и флаги командной строки. Значения не проверяются,
+// кроме ошибок разбора
+func Parse(args []string) (*Config, error) {
+	fs := flag.NewFlagSet("dormitory-helper", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML config file")
+	envFile := fs.String("env-file", ".env", "path to a .env file, ignored if it does not exist")
+	host := fs.String("host", "", "address to listen on")
+	httpPort := fs.Int("http-port", 0, "HTTP gateway port")
+	grpcPort := fs.Int("grpc-port", 0, "gRPC port")
+	logLevel := fs.String("log-level", "", "log level: debug, info, warn or error")
+	logFormat := fs.String("log-format", "", "log format: json or text")
+	autoMigrate := fs.Bool("migrate", false, "apply database migrations on start")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Defaults()
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// .env не переопределяет уже заданные переменные окружения
+	if err := godotenv.Load(*envFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load %s: %w", *envFile, err)
+	}
+
+	errs := cfg.loadEnv()
+
+	// Флаги применяются, только если заданы явно
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "host":
+			cfg.ServerConfig.Host = *host
+		case "http-port":
+			cfg.ServerConfig.Port = *httpPort
+		case "grpc-port":
+			cfg.ServerConfig.GRPCPort = *grpcPort
+		case "log-level":
+			cfg.LogConfig.Level = *logLevel
+		case "log-format":
+			cfg.LogConfig.Format = *logFormat
+		case "migrate":
+			cfg.DatabaseConfig.AutoMigrate = *autoMigrate
+		}
+	})
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// envSetter разбирает значение переменной окружения в поле конфигурации
+type envSetter func(value string) error
+
+// loadEnv применяет переменные окружения. Переменные с суффиксом _FILE
+// содержат путь к файлу с секретом и имеют приоритет над самим секретом
+func (c *Config) loadEnv() []error {
+	vars := []struct {
+		name string
+		set  envSetter
+	}{
+		{"SERVER_HOST", stringVar(&c.ServerConfig.Host)},
+		{"SERVER_PORT", intVar(&c.ServerConfig.Port)},
+		{"SERVER_GRPC_PORT", intVar(&c.ServerConfig.GRPCPort)},
+		{"JWT_SECRET_KEY", secretVar(&c.ServerConfig.JWTSecretKey)},
+		{"JWT_SECRET_KEY_FILE", secretFileVar(&c.ServerConfig.JWTSecretKey)},
+		{"JWT_TTL", durationVar(&c.ServerConfig.JWTTTL)},
+		{"SERVER_SHUTDOWN_TIMEOUT", durationVar(&c.ServerConfig.ShutdownTimeout)},
+		{"SERVER_SHUTDOWN_DELAY", durationVar(&c.ServerConfig.ShutdownDelay)},
+		{"SERVER_READ_TIMEOUT", durationVar(&c.ServerConfig.ReadTimeout)},
+		{"SERVER_WRITE_TIMEOUT", durationVar(&c.ServerConfig.WriteTimeout)},
+		{"SERVER_IDLE_TIMEOUT", durationVar(&c.ServerConfig.IdleTimeout)},
+		{"CORS_ALLOWED_ORIGINS", listVar(&c.ServerConfig.CORSAllowedOrigins)},
+
+		{"DATABASE_HOST", stringVar(&c.DatabaseConfig.Host)},
+		{"DATABASE_PORT", intVar(&c.DatabaseConfig.Port)},
+		{"DATABASE_NAME", stringVar(&c.DatabaseConfig.DBName)},
+		{"DATABASE_USER", stringVar(&c.DatabaseConfig.User)},
+		{"DATABASE_PASSWORD", secretVar(&c.DatabaseConfig.Password)},
+		{"DATABASE_PASSWORD_FILE", secretFileVar(&c.DatabaseConfig.Password)},
+		{"DRIVER", stringVar(&c.DatabaseConfig.Driver)},
+		{"DATABASE_SSLMODE", stringVar(&c.DatabaseConfig.SSLMode)},
+		{"DATABASE_MAX_CONNS", int32Var(&c.DatabaseConfig.MaxConns)},
+		{"DATABASE_MIN_CONNS", int32Var(&c.DatabaseConfig.MinConns)},
+		{"DATABASE_AUTO_MIGRATE", boolVar(&c.DatabaseConfig.AutoMigrate)},
+
+		{"SCHEDULER_CLEANUP_INTERVAL", durationVar(&c.SchedulerConfig.CleanupInterval)},
+		{"SCHEDULER_PURGE_INTERVAL", durationVar(&c.SchedulerConfig.PurgeInterval)},
+		{"SCHEDULER_JITTER", durationVar(&c.SchedulerConfig.Jitter)},
+		{"BOOKING_RETENTION", durationVar(&c.SchedulerConfig.BookingRetention)},
+		{"SCHEDULER_WAITLIST_INTERVAL", durationVar(&c.SchedulerConfig.WaitlistInterval)},
+		{"SCHEDULER_NO_SHOW_INTERVAL", durationVar(&c.SchedulerConfig.NoShowInterval)},
+
+		{"LOG_FORMAT", stringVar(&c.LogConfig.Format)},
+		{"LOG_LEVEL", stringVar(&c.LogConfig.Level)},
+
+		{"TRACING_EXPORTER", stringVar(&c.TracingConfig.Exporter)},
+		{"TRACING_OTLP_ENDPOINT", stringVar(&c.TracingConfig.OTLPEndpoint)},
+		{"TRACING_OTLP_INSECURE", boolVar(&c.TracingConfig.OTLPInsecure)},
+		{"TRACING_SAMPLE_RATIO", floatVar(&c.TracingConfig.SampleRatio)},
+
+		{"USER_TTL", durationVar(&c.UserConfig.TTL)},
+		{"USER_ADMIN_IDS", intListVar(&c.UserConfig.AdminIDs)},
+
+		{"BOOKING_MAX_ADVANCE", durationVar(&c.BookingConfig.MaxAdvance)},
+		{"BOOKING_TIME_ZONE", stringVar(&c.BookingConfig.TimeZone)},
+		{"BOOKING_WAITLIST_HOLD", durationVar(&c.BookingConfig.WaitlistHold)},
+		{"BOOKING_NO_SHOW_LIMIT", intVar(&c.BookingConfig.NoShowLimit)},
+		{"BOOKING_NO_SHOW_WINDOW", durationVar(&c.BookingConfig.NoShowWindow)},
+		{"BOOKING_NO_SHOW_BAN", durationVar(&c.BookingConfig.NoShowBan)},
+		{"BOOKING_SLOT_GRID", durationVar(&c.BookingConfig.SlotGrid)},
+	}
+
+	var errs []error
+	for _, v := range vars {
+		value, ok := os.LookupEnv(v.name)
+		if !ok || value == "" {
+			continue
+		}
+		if err := v.set(value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", v.name, err))
+		}
+	}
+
+	return errs
+}
+
+func stringVar(p *string) envSetter {
+	return func(value string) error {
+		*p = value
+		return nil
+	}
+}
+
+func intVar(p *int) envSetter {
+	return func(value string) error {
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*p = v
+		return nil
+	}
+}
+
+func int32Var(p *int32) envSetter {
+	return func(value string) error {
+		v, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return err
+		}
+		*p = int32(v)
+		return nil
+	}
+}
+
+func boolVar(p *bool) envSetter {
+	return func(value string) error {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*p = v
+		return nil
+	}
+}
+
+func floatVar(p *float64) envSetter {
+	return func(value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*p = v
+		return nil
+	}
+}
+
+func durationVar(p *time.Duration) envSetter {
+	return func(value string) error {
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*p = v
+		return nil
+	}
+}
+
+// listVar разбирает список через запятую
+func listVar(p *[]string) envSetter {
+	return func(value string) error {
+		var list []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		*p = list
+		return nil
+	}
+}
+
+// intListVar разбирает список чисел через запятую
+func intListVar(p *[]int) envSetter {
+	return func(value string) error {
+		var list []int
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			v, err := strconv.Atoi(item)
+			if err != nil {
+				return err
+			}
+			list = append(list, v)
+		}
+		*p = list
+		return nil
+	}
+}
+
+func secretVar(p *Secret) envSetter {
+	return func(value string) error {
+		*p = Secret(value)
+		return nil
+	}
+}
+
+// secretFileVar читает секрет из файла (например, Docker или Kubernetes secret)
+func secretFileVar(p *Secret) envSetter {
+	return func(path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		*p = Secret(strings.TrimSpace(string(data)))
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// Validate проверяет конфигурацию и возвращает все найденные проблемы одной ошибкой
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	// Сервер
+	check(c.ServerConfig.Host != "", "server host is required")
+	check(validPort(c.ServerConfig.Port), "server port %d is out of range", c.ServerConfig.Port)
+	check(validPort(c.ServerConfig.GRPCPort), "server gRPC port %d is out of range", c.ServerConfig.GRPCPort)
+	check(c.ServerConfig.Port != c.ServerConfig.GRPCPort, "server HTTP and gRPC ports must differ")
+	check(len(c.ServerConfig.JWTSecretKey) > 0, "JWT secret key is required")
+	check(c.ServerConfig.JWTTTL > 0, "JWT TTL must be positive")
+	check(c.ServerConfig.ShutdownTimeout > 0, "server shutdown timeout must be positive")
+	check(c.ServerConfig.ShutdownDelay >= 0 && c.ServerConfig.ShutdownDelay < c.ServerConfig.ShutdownTimeout,
+		"server shutdown delay must not be negative and must be less than the shutdown timeout")
+	check(c.ServerConfig.ReadTimeout >= 0, "server read timeout must not be negative")
+	check(c.ServerConfig.WriteTimeout >= 0, "server write timeout must not be negative")
+	check(c.ServerConfig.IdleTimeout >= 0, "server idle timeout must not be negative")
+	check(len(c.ServerConfig.CORSAllowedOrigins) > 0, "at least one CORS origin is required")
+
+	// База данных
+	if err := c.DatabaseConfig.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Фоновые задачи
+	check(c.SchedulerConfig.CleanupInterval > 0, "scheduler cleanup interval must be positive")
+	check(c.SchedulerConfig.PurgeInterval > 0, "scheduler purge interval must be positive")
+	check(c.SchedulerConfig.Jitter >= 0, "scheduler jitter must not be negative")
+	check(c.SchedulerConfig.BookingRetention > 0, "booking retention must be positive")
+	check(c.SchedulerConfig.WaitlistInterval > 0, "scheduler waitlist interval must be positive")
+	check(c.SchedulerConfig.NoShowInterval > 0, "scheduler no-show interval must be positive")
+
+	// Логирование
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.LogConfig.Level)) == nil, "log level %q is not supported", c.LogConfig.Level)
+	check(slices.Contains([]string{"json", "text"}, c.LogConfig.Format), "log format %q is not supported", c.LogConfig.Format)
+
+	// Трассировка
+	check(slices.Contains([]string{"none", "stdout", "otlp"}, c.TracingConfig.Exporter),
+		"tracing exporter %q is not supported", c.TracingConfig.Exporter)
+	check(c.TracingConfig.Exporter != "otlp" || c.TracingConfig.OTLPEndpoint != "",
+		"tracing OTLP endpoint is required for the otlp exporter")
+	check(c.TracingConfig.SampleRatio >= 0 && c.TracingConfig.SampleRatio <= 1,
+		"tracing sample ratio must be between 0 and 1")
+
+	// Пользователи и записи
+	check(c.UserConfig.TTL > 0, "user TTL must be positive")
+	check(!slices.ContainsFunc(c.UserConfig.AdminIDs, func(id int) bool { return id <= 0 }),
+		"user admin IDs must be positive")
+	check(c.BookingConfig.MaxAdvance >= 0, "booking max advance must not be negative")
+	check(c.BookingConfig.WaitlistHold > 0, "booking waitlist hold must be positive")
+	check(c.BookingConfig.NoShowLimit >= 0, "booking no-show limit must not be negative")
+	check(c.BookingConfig.NoShowLimit == 0 || c.BookingConfig.NoShowWindow > 0,
+		"booking no-show window must be positive when the no-show limit is set")
+	check(c.BookingConfig.NoShowLimit == 0 || c.BookingConfig.NoShowBan > 0,
+		"booking no-show ban must be positive when the no-show limit is set")
+	check(c.BookingConfig.SlotGrid >= time.Minute && c.BookingConfig.SlotGrid%time.Minute == 0,
+		"booking slot grid must be a whole number of minutes")
+	if _, err := c.BookingConfig.Location(); err != nil {
+		errs = append(errs, fmt.Errorf("booking time zone %q is not supported: %w", c.BookingConfig.TimeZone, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate проверяет настройки подключения к базе данных. Используется отдельно
+// командой migrate, которой не нужна остальная конфигурация
+func (c DatabaseConfig) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Host != "", "database host is required")
+	check(validPort(c.Port), "database port %d is out of range", c.Port)
+	check(c.DBName != "", "database name is required")
+	check(c.User != "", "database user is required")
+	check(len(c.Password) > 0, "database password is required")
+	check(slices.Contains([]string{"postgres", "postgresql"}, c.Driver),
+		"database driver %q is not supported", c.Driver)
+	check(slices.Contains([]string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}, c.SSLMode),
+		"database sslmode %q is not supported", c.SSLMode)
+	check(c.MaxConns > 0, "database max connections must be positive")
+	check(c.MinConns >= 0 && c.MinConns <= c.MaxConns,
+		"database min connections must be between 0 and max connections")
+
+	return errors.Join(errs...)
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
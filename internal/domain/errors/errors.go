@@ -0,0 +1,87 @@
+package domainErrors
+
+import "errors"
+
+// Виды доменных ошибок. Проверяются через errors.Is
+var (
+	ErrConflict   = errors.New("conflict")
+	ErrNotFound   = errors.New("not found")
+	ErrForbidden  = errors.New("forbidden")
+	ErrValidation = errors.New("validation failed")
+	ErrQuota      = errors.New("quota exceeded")
+)
+
+// FieldViolation описывает ошибку валидации конкретного поля запроса
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error доменная ошибка, которую возвращают репозитории и сервисы.
+// Reason - машиночитаемая причина (например, TIME_SLOT_BOOKED), которую может обработать фронтенд
+type Error struct {
+	kind       error
+	Reason     string
+	Message    string
+	Violations []FieldViolation
+	Metadata   map[string]string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is позволяет проверять вид ошибки: errors.Is(err, domainErrors.ErrConflict)
+func (e *Error) Is(target error) bool {
+	return target == e.kind
+}
+
+// Kind возвращает вид ошибки (ErrConflict, ErrNotFound, ErrForbidden, ErrValidation или ErrQuota)
+func (e *Error) Kind() error {
+	return e.kind
+}
+
+// WithMetadata возвращает копию ошибки с дополнительными данными для клиента
+func (e *Error) WithMetadata(key, value string) *Error {
+	clone := *e
+	clone.Metadata = make(map[string]string, len(e.Metadata)+1)
+	for k, v := range e.Metadata {
+		clone.Metadata[k] = v
+	}
+	clone.Metadata[key] = value
+	return &clone
+}
+
+// Conflict ресурс уже занят или состояние не позволяет выполнить операцию
+func Conflict(reason, message string) *Error {
+	return &Error{kind: ErrConflict, Reason: reason, Message: message}
+}
+
+// NotFound объект не найден
+func NotFound(reason, message string) *Error {
+	return &Error{kind: ErrNotFound, Reason: reason, Message: message}
+}
+
+// Forbidden у пользователя нет прав на операцию
+func Forbidden(reason, message string) *Error {
+	return &Error{kind: ErrForbidden, Reason: reason, Message: message}
+}
+
+// Validation некорректные входные данные
+func Validation(reason, message string, violations ...FieldViolation) *Error {
+	return &Error{kind: ErrValidation, Reason: reason, Message: message, Violations: violations}
+}
+
+// QuotaExceeded пользователь исчерпал лимит
+func QuotaExceeded(reason, message string) *Error {
+	return &Error{kind: ErrQuota, Reason: reason, Message: message}
+}
+
+// As возвращает доменную ошибку из цепочки err, если она там есть
+func As(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,285 @@
+package adminServer
+
+import (
+	"context"
+	adminProto "dormitory-helper-service/generated/proto/admin"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"dormitory-helper-service/internal/scheduler"
+	grpcUtils "dormitory-helper-service/internal/utils/grpc"
+	jwtUtils "dormitory-helper-service/internal/utils/jwt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminService interface {
+	ListAllBookings(ctx context.Context, filter bookingRepository.BookingFilter) ([]bookingRepository.BookingDetails, error)
+	ForceDeleteBooking(ctx context.Context, bookingID int) error
+	MoveBooking(ctx context.Context, bookingID, resourceID int, startTime, endTime time.Time) error
+	BanUser(ctx context.Context, adminID, userID int, reason string, bannedUntil *time.Time) error
+	UnbanUser(ctx context.Context, userID int) error
+	SetUserRole(ctx context.Context, adminID, userID int, role string) error
+	CreateBlackout(ctx context.Context, blackout bookingRepository.Blackout, cancelBookings bool) (int, int, error)
+	DeleteBlackout(ctx context.Context, blackoutID int) error
+	ListBlackouts(ctx context.Context, filter bookingRepository.BlackoutFilter) ([]bookingRepository.Blackout, error)
+	RotateCheckInToken(ctx context.Context, resourceID int) (string, error)
+}
+
+type Scheduler interface {
+	Jobs() []scheduler.JobState
+}
+
+// Server реализация AdminService. Роль администратора проверяется
+// интерцептором grpcUtils.RequireRoleUnaryInterceptor
+type Server struct {
+	adminProto.UnimplementedAdminServiceServer
+	service   AdminService
+	scheduler Scheduler
+}
+
+func NewServer(service AdminService, scheduler Scheduler) *Server {
+	return &Server{
+		service:   service,
+		scheduler: scheduler,
+	}
+}
+
+func (s *Server) ListAllBookings(ctx context.Context, req *adminProto.ListAllBookingsRequest) (*adminProto.ListAllBookingsResponse, error) {
+	filter := bookingRepository.BookingFilter{
+		ResourceType: req.GetResourceType(),
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		filter.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		filter.EndTime = &t
+	}
+
+	bookings, err := s.service.ListAllBookings(ctx, filter)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to list bookings")
+	}
+
+	response := &adminProto.ListAllBookingsResponse{
+		Bookings: make([]*adminProto.AdminBooking, len(bookings)),
+	}
+
+	for i, b := range bookings {
+		response.Bookings[i] = &adminProto.AdminBooking{
+			Id:           int32(b.ID),
+			ResourceId:   int32(b.ResourceID),
+			ResourceType: b.ResourceType,
+			ResourceName: b.ResourceName,
+			UserId:       int32(b.UserID),
+			Username:     b.Username,
+			StartTime:    timestamppb.New(b.StartTime),
+			EndTime:      timestamppb.New(b.EndTime),
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Server) ForceDeleteBooking(ctx context.Context, req *adminProto.ForceDeleteBookingRequest) (*adminProto.ForceDeleteBookingResponse, error) {
+	if err := s.service.ForceDeleteBooking(ctx, int(req.BookingId)); err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to delete booking")
+	}
+
+	return &adminProto.ForceDeleteBookingResponse{
+		Message: "Booking deleted successfully",
+	}, nil
+}
+
+func (s *Server) MoveBooking(ctx context.Context, req *adminProto.MoveBookingRequest) (*adminProto.MoveBookingResponse, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required")
+	}
+
+	err := s.service.MoveBooking(ctx, int(req.BookingId), int(req.GetResourceId()), req.StartTime.AsTime(), req.EndTime.AsTime())
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to move booking")
+	}
+
+	return &adminProto.MoveBookingResponse{
+		Message: "Booking moved successfully",
+	}, nil
+}
+
+func (s *Server) BanUser(ctx context.Context, req *adminProto.BanUserRequest) (*adminProto.BanUserResponse, error) {
+	adminID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bannedUntil *time.Time
+	if req.BannedUntil != nil {
+		t := req.BannedUntil.AsTime()
+		bannedUntil = &t
+	}
+
+	if err := s.service.BanUser(ctx, adminID, int(req.UserId), req.Reason, bannedUntil); err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to ban user")
+	}
+
+	return &adminProto.BanUserResponse{
+		Message: "User banned from booking",
+	}, nil
+}
+
+func (s *Server) UnbanUser(ctx context.Context, req *adminProto.UnbanUserRequest) (*adminProto.UnbanUserResponse, error) {
+	if err := s.service.UnbanUser(ctx, int(req.UserId)); err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to unban user")
+	}
+
+	return &adminProto.UnbanUserResponse{
+		Message: "User unbanned",
+	}, nil
+}
+
+func (s *Server) SetUserRole(ctx context.Context, req *adminProto.SetUserRoleRequest) (*adminProto.SetUserRoleResponse, error) {
+	adminID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var role string
+	switch req.Role {
+	case adminProto.UserRole_USER_ROLE_USER:
+		role = jwtUtils.RoleUser
+	case adminProto.UserRole_USER_ROLE_ADMIN:
+		role = jwtUtils.RoleAdmin
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+
+	if err := s.service.SetUserRole(ctx, adminID, int(req.UserId), role); err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to set user role")
+	}
+
+	return &adminProto.SetUserRoleResponse{
+		Message: "User role updated",
+	}, nil
+}
+
+func (s *Server) ListJobs(ctx context.Context, req *adminProto.ListJobsRequest) (*adminProto.ListJobsResponse, error) {
+	jobs := s.scheduler.Jobs()
+
+	response := &adminProto.ListJobsResponse{
+		Jobs: make([]*adminProto.Job, len(jobs)),
+	}
+
+	for i, job := range jobs {
+		protoJob := &adminProto.Job{
+			Name:            job.Name,
+			IntervalSeconds: int64(job.Interval.Seconds()),
+			LastError:       job.LastError,
+			Runs:            make([]*adminProto.JobRun, len(job.History)),
+		}
+		if job.LastErrorAt != nil {
+			protoJob.LastErrorAt = timestamppb.New(*job.LastErrorAt)
+		}
+
+		for j, run := range job.History {
+			protoJob.Runs[j] = &adminProto.JobRun{
+				StartedAt:  timestamppb.New(run.StartedAt),
+				FinishedAt: timestamppb.New(run.FinishedAt),
+				Status:     string(run.Status),
+				Error:      run.Error,
+			}
+		}
+
+		response.Jobs[i] = protoJob
+	}
+
+	return response, nil
+}
+
+func (s *Server) CreateBlackout(ctx context.Context, req *adminProto.CreateBlackoutRequest) (*adminProto.CreateBlackoutResponse, error) {
+	adminID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required")
+	}
+
+	blackoutID, cancelled, err := s.service.CreateBlackout(ctx, bookingRepository.Blackout{
+		ResourceID: int(req.ResourceId),
+		StartTime:  req.StartTime.AsTime(),
+		EndTime:    req.EndTime.AsTime(),
+		Reason:     req.Reason,
+		CreatedBy:  adminID,
+	}, req.CancelBookings)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to create blackout")
+	}
+
+	return &adminProto.CreateBlackoutResponse{
+		BlackoutId:        int32(blackoutID),
+		CancelledBookings: int32(cancelled),
+		Message:           "Blackout created successfully",
+	}, nil
+}
+
+func (s *Server) DeleteBlackout(ctx context.Context, req *adminProto.DeleteBlackoutRequest) (*adminProto.DeleteBlackoutResponse, error) {
+	if err := s.service.DeleteBlackout(ctx, int(req.BlackoutId)); err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to delete blackout")
+	}
+
+	return &adminProto.DeleteBlackoutResponse{
+		Message: "Blackout deleted successfully",
+	}, nil
+}
+
+func (s *Server) ListBlackouts(ctx context.Context, req *adminProto.ListBlackoutsRequest) (*adminProto.ListBlackoutsResponse, error) {
+	filter := bookingRepository.BlackoutFilter{
+		ResourceID: int(req.GetResourceId()),
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		filter.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		filter.EndTime = &t
+	}
+
+	blackouts, err := s.service.ListBlackouts(ctx, filter)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to list blackouts")
+	}
+
+	response := &adminProto.ListBlackoutsResponse{
+		Blackouts: make([]*adminProto.Blackout, len(blackouts)),
+	}
+
+	for i, b := range blackouts {
+		response.Blackouts[i] = &adminProto.Blackout{
+			Id:         int32(b.ID),
+			ResourceId: int32(b.ResourceID),
+			StartTime:  timestamppb.New(b.StartTime),
+			EndTime:    timestamppb.New(b.EndTime),
+			Reason:     b.Reason,
+			CreatedBy:  int32(b.CreatedBy),
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Server) RotateCheckInToken(ctx context.Context, req *adminProto.RotateCheckInTokenRequest) (*adminProto.RotateCheckInTokenResponse, error) {
+	token, err := s.service.RotateCheckInToken(ctx, int(req.ResourceId))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to rotate check-in token")
+	}
+
+	return &adminProto.RotateCheckInTokenResponse{
+		CheckInToken: token,
+		Message:      "Check-in token rotated successfully",
+	}, nil
+}
@@ -0,0 +1,466 @@
+package bookingServer
+
+import (
+	"context"
+	bookingProto "dormitory-helper-service/generated/proto/booking"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	bookingService "dormitory-helper-service/internal/service/booking"
+	grpcUtils "dormitory-helper-service/internal/utils/grpc"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type BookingService interface {
+	ListResources(ctx context.Context, resourceType string) ([]bookingRepository.Resource, error)
+	CreateBooking(ctx context.Context, userID, resourceID int, startTime, endTime time.Time) (int, error)
+	GetBookings(ctx context.Context, filter bookingRepository.BookingFilter) ([]bookingRepository.Booking, error)
+	DeleteBooking(ctx context.Context, bookingID, userID int, resourceType string) error
+	DayRange(date string) (time.Time, time.Time, error)
+	Location() *time.Location
+	GetFacilitySchedule(ctx context.Context, resourceType, fromDate string, days int) (bookingService.FacilitySchedule, error)
+	GetMyQuota(ctx context.Context, userID int, resourceType string) (bookingService.QuotaStatus, error)
+	CreateSeries(ctx context.Context, series bookingRepository.Series) (int, []bookingService.SeriesOccurrence, error)
+	GetMySeries(ctx context.Context, userID int) ([]bookingService.SeriesDetails, error)
+	CancelSeries(ctx context.Context, seriesID, userID int, scope string, bookingID int) (int, error)
+	DateRange(fromDate, toDate string) (time.Time, time.Time, error)
+	FindAvailableSlots(ctx context.Context, query bookingService.SlotQuery) ([]bookingService.Slot, error)
+	FindNextAvailableSlot(ctx context.Context, query bookingService.SlotQuery) (bookingService.Slot, error)
+}
+
+type Server struct {
+	bookingProto.UnimplementedBookingServiceServer
+	service BookingService
+}
+
+func NewServer(service BookingService) *Server {
+	return &Server{
+		service: service,
+	}
+}
+
+func (s *Server) ListResources(ctx context.Context, req *bookingProto.ListResourcesRequest) (*bookingProto.ListResourcesResponse, error) {
+	resources, err := s.service.ListResources(ctx, req.GetType())
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to list resources")
+	}
+
+	response := &bookingProto.ListResourcesResponse{
+		Resources: make([]*bookingProto.Resource, len(resources)),
+		TimeZone:  s.service.Location().String(),
+	}
+
+	for i, r := range resources {
+		response.Resources[i] = &bookingProto.Resource{
+			Id:                 int32(r.ID),
+			Type:               r.Type,
+			Name:               r.Name,
+			MaxDurationMinutes: int32(r.MaxDurationMinutes),
+			Capacity:           int32(r.Capacity),
+			Rules: &bookingProto.ResourceRules{
+				MinDurationMinutes:  int32(r.Rules.MinDurationMinutes),
+				SlotStepMinutes:     int32(r.Rules.SlotStepMinutes),
+				CheckInGraceMinutes: int32(r.Rules.CheckInGraceMinutes),
+			},
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetFacilitySchedule(ctx context.Context, req *bookingProto.GetFacilityScheduleRequest) (*bookingProto.GetFacilityScheduleResponse, error) {
+	if req.ResourceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type is required")
+	}
+
+	schedule, err := s.service.GetFacilitySchedule(ctx, req.ResourceType, req.GetFromDate(), int(req.GetDays()))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to get facility schedule")
+	}
+
+	response := &bookingProto.GetFacilityScheduleResponse{
+		TimeZone:      s.service.Location().String(),
+		OpeningHours:  toProtoWindows(schedule.OpeningHours),
+		QuietHours:    toProtoWindows(schedule.QuietHours),
+		ClosedPeriods: make([]*bookingProto.ClosedPeriod, len(schedule.ClosedPeriods)),
+	}
+
+	for i, p := range schedule.ClosedPeriods {
+		response.ClosedPeriods[i] = &bookingProto.ClosedPeriod{
+			StartTime: timestamppb.New(p.Start),
+			EndTime:   timestamppb.New(p.End),
+		}
+	}
+
+	return response, nil
+}
+
+func (s *Server) CreateBooking(ctx context.Context, req *bookingProto.CreateBookingRequest) (*bookingProto.CreateBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required")
+	}
+
+	bookingID, err := s.service.CreateBooking(ctx, userID, int(req.ResourceId), req.StartTime.AsTime(), req.EndTime.AsTime())
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to create booking")
+	}
+
+	return &bookingProto.CreateBookingResponse{
+		BookingId: int32(bookingID),
+		Message:   "Booking created successfully",
+	}, nil
+}
+
+func (s *Server) GetBookings(ctx context.Context, req *bookingProto.GetBookingsRequest) (*bookingProto.GetBookingsResponse, error) {
+	filter := bookingRepository.BookingFilter{
+		ResourceID: int(req.ResourceId),
+	}
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		filter.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		filter.EndTime = &t
+	}
+	if req.Date != nil {
+		dayStart, dayEnd, err := s.service.DayRange(req.GetDate())
+		if err != nil {
+			return nil, grpcUtils.StatusFromError(err, "invalid date")
+		}
+		if filter.StartTime == nil || filter.StartTime.Before(dayStart) {
+			filter.StartTime = &dayStart
+		}
+		filter.StartBefore = &dayEnd
+	}
+
+	bookings, err := s.service.GetBookings(ctx, filter)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to get bookings")
+	}
+
+	return &bookingProto.GetBookingsResponse{
+		Bookings: toProtoBookings(bookings),
+	}, nil
+}
+
+func (s *Server) GetUserBookings(ctx context.Context, req *bookingProto.GetUserBookingsRequest) (*bookingProto.GetUserBookingsResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.service.GetBookings(ctx, bookingRepository.BookingFilter{
+		ResourceType: req.GetResourceType(),
+		UserID:       userID,
+	})
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to get user bookings")
+	}
+
+	return &bookingProto.GetUserBookingsResponse{
+		Bookings: toProtoBookings(bookings),
+	}, nil
+}
+
+func (s *Server) FindAvailableSlots(ctx context.Context, req *bookingProto.FindAvailableSlotsRequest) (*bookingProto.FindAvailableSlotsResponse, error) {
+	if req.ResourceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type is required")
+	}
+
+	from, to, err := s.service.DateRange(req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find available slots")
+	}
+
+	slots, err := s.service.FindAvailableSlots(ctx, bookingService.SlotQuery{
+		ResourceType: req.ResourceType,
+		ResourceIDs:  resourceIDs(req.ResourceId),
+		From:         from,
+		To:           to,
+		Duration:     time.Duration(req.DurationMinutes) * time.Minute,
+		Limit:        int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find available slots")
+	}
+
+	response := &bookingProto.FindAvailableSlotsResponse{
+		Slots:    make([]*bookingProto.AvailableSlot, len(slots)),
+		TimeZone: s.service.Location().String(),
+	}
+
+	for i, slot := range slots {
+		response.Slots[i] = toProtoSlot(slot)
+	}
+
+	return response, nil
+}
+
+func (s *Server) FindNextAvailableSlot(ctx context.Context, req *bookingProto.FindNextAvailableSlotRequest) (*bookingProto.FindNextAvailableSlotResponse, error) {
+	if req.ResourceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type is required")
+	}
+
+	slot, err := s.service.FindNextAvailableSlot(ctx, bookingService.SlotQuery{
+		ResourceType: req.ResourceType,
+		ResourceIDs:  resourceIDs(req.ResourceId),
+		Duration:     time.Duration(req.DurationMinutes) * time.Minute,
+	})
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find next available slot")
+	}
+
+	return &bookingProto.FindNextAvailableSlotResponse{
+		Slot: toProtoSlot(slot),
+	}, nil
+}
+
+// resourceIDs возвращает ресурс из необязательного поля запроса. Пустой список - все ресурсы
+func resourceIDs(resourceID *int32) []int {
+	if resourceID == nil {
+		return nil
+	}
+	return []int{int(*resourceID)}
+}
+
+func toProtoSlot(slot bookingService.Slot) *bookingProto.AvailableSlot {
+	return &bookingProto.AvailableSlot{
+		ResourceId: int32(slot.ResourceID),
+		StartTime:  timestamppb.New(slot.StartTime),
+		EndTime:    timestamppb.New(slot.EndTime),
+	}
+}
+
+func (s *Server) GetMyQuota(ctx context.Context, req *bookingProto.GetMyQuotaRequest) (*bookingProto.GetMyQuotaResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ResourceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type is required")
+	}
+
+	quota, err := s.service.GetMyQuota(ctx, userID, req.ResourceType)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to get quota")
+	}
+
+	response := &bookingProto.GetMyQuotaResponse{
+		ResourceType:        req.ResourceType,
+		ActiveBookings:      int32(quota.Usage.ActiveBookings),
+		UsedMinutesToday:    int32(quota.Usage.DayMinutes),
+		UsedMinutesThisWeek: int32(quota.Usage.WeekMinutes),
+		MinGapMinutes:       optionalLimit(quota.Quota.MinGapMinutes),
+		MaxAdvanceMinutes:   optionalLimit(quota.Quota.MaxAdvanceMinutes),
+		RecentNoShows:       int32(quota.NoShows),
+		MaxNoShows:          optionalLimit(quota.NoShowPolicy.Limit),
+		NoShowWindowDays:    int32(quota.NoShowPolicy.Window / (24 * time.Hour)),
+	}
+
+	if limit := quota.Quota.MaxActiveBookings; limit > 0 {
+		response.MaxActiveBookings = optionalLimit(limit)
+		response.RemainingActiveBookings = proto.Int32(int32(max(limit-quota.Usage.ActiveBookings, 0)))
+	}
+	if limit := quota.Quota.MaxMinutesPerDay; limit > 0 {
+		response.MaxMinutesPerDay = optionalLimit(limit)
+		response.RemainingMinutesToday = proto.Int32(int32(max(limit-quota.Usage.DayMinutes, 0)))
+	}
+	if limit := quota.Quota.MaxMinutesPerWeek; limit > 0 {
+		response.MaxMinutesPerWeek = optionalLimit(limit)
+		response.RemainingMinutesThisWeek = proto.Int32(int32(max(limit-quota.Usage.WeekMinutes, 0)))
+	}
+
+	return response, nil
+}
+
+// optionalLimit возвращает nil для лимита 0 (без ограничения)
+func optionalLimit(limit int) *int32 {
+	if limit <= 0 {
+		return nil
+	}
+	return proto.Int32(int32(limit))
+}
+
+func (s *Server) DeleteBooking(ctx context.Context, req *bookingProto.DeleteBookingRequest) (*bookingProto.DeleteBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.service.DeleteBooking(ctx, int(req.BookingId), userID, "")
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to delete booking")
+	}
+
+	return &bookingProto.DeleteBookingResponse{
+		Message: "Booking deleted successfully",
+	}, nil
+}
+
+func (s *Server) CreateBookingSeries(ctx context.Context, req *bookingProto.CreateBookingSeriesRequest) (*bookingProto.CreateBookingSeriesResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time are required")
+	}
+
+	series := bookingRepository.Series{
+		UserID:        userID,
+		ResourceID:    int(req.ResourceId),
+		StartTime:     req.StartTime.AsTime(),
+		EndTime:       req.EndTime.AsTime(),
+		IntervalWeeks: int(req.IntervalWeeks),
+		Count:         int(req.GetCount()),
+	}
+	if req.UntilDate != nil {
+		// Серия включает последний день целиком
+		_, untilEnd, err := s.service.DayRange(req.GetUntilDate())
+		if err != nil {
+			return nil, grpcUtils.StatusFromError(err, "invalid until_date")
+		}
+		series.Until = &untilEnd
+	}
+
+	seriesID, occurrences, err := s.service.CreateSeries(ctx, series)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to create booking series")
+	}
+
+	response := &bookingProto.CreateBookingSeriesResponse{
+		SeriesId:    int32(seriesID),
+		Occurrences: make([]*bookingProto.SeriesOccurrence, len(occurrences)),
+		Message:     "Booking series created successfully",
+	}
+
+	for i, o := range occurrences {
+		occurrence := &bookingProto.SeriesOccurrence{
+			StartTime: timestamppb.New(o.StartTime),
+			EndTime:   timestamppb.New(o.EndTime),
+		}
+		if o.Err != nil {
+			occurrence.ErrorReason = o.Err.Reason
+			occurrence.ErrorMessage = o.Err.Message
+			response.Message = "Booking series created, some occurrences could not be booked"
+		} else {
+			occurrence.BookingId = proto.Int32(int32(o.BookingID))
+		}
+		response.Occurrences[i] = occurrence
+	}
+
+	return response, nil
+}
+
+func (s *Server) GetMyBookingSeries(ctx context.Context, req *bookingProto.GetMyBookingSeriesRequest) (*bookingProto.GetMyBookingSeriesResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.service.GetMySeries(ctx, userID)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to get booking series")
+	}
+
+	response := &bookingProto.GetMyBookingSeriesResponse{
+		Series: make([]*bookingProto.BookingSeries, len(series)),
+	}
+
+	for i, sr := range series {
+		item := &bookingProto.BookingSeries{
+			Id:            int32(sr.ID),
+			ResourceId:    int32(sr.ResourceID),
+			StartTime:     timestamppb.New(sr.StartTime),
+			EndTime:       timestamppb.New(sr.EndTime),
+			IntervalWeeks: int32(sr.IntervalWeeks),
+			Bookings:      toProtoBookings(sr.Bookings),
+			CreatedAt:     timestamppb.New(sr.CreatedAt),
+		}
+		if sr.Count > 0 {
+			item.Count = proto.Int32(int32(sr.Count))
+		}
+		if sr.Until != nil {
+			item.Until = timestamppb.New(*sr.Until)
+		}
+		response.Series[i] = item
+	}
+
+	return response, nil
+}
+
+func (s *Server) CancelBookingSeries(ctx context.Context, req *bookingProto.CancelBookingSeriesRequest) (*bookingProto.CancelBookingSeriesResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, ok := seriesScopes[req.Scope]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "scope is required")
+	}
+
+	cancelled, err := s.service.CancelSeries(ctx, int(req.SeriesId), userID, scope, int(req.GetBookingId()))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to cancel booking series")
+	}
+
+	return &bookingProto.CancelBookingSeriesResponse{
+		CancelledBookings: int32(cancelled),
+		Message:           "Booking series cancelled successfully",
+	}, nil
+}
+
+// seriesScopes соответствие области отмены серии в API и в сервисе
+var seriesScopes = map[bookingProto.SeriesCancelScope]string{
+	bookingProto.SeriesCancelScope_SERIES_CANCEL_SCOPE_OCCURRENCE: bookingService.SeriesScopeOccurrence,
+	bookingProto.SeriesCancelScope_SERIES_CANCEL_SCOPE_FOLLOWING:  bookingService.SeriesScopeFollowing,
+	bookingProto.SeriesCancelScope_SERIES_CANCEL_SCOPE_ALL:        bookingService.SeriesScopeAll,
+}
+
+func toProtoBookings(bookings []bookingRepository.Booking) []*bookingProto.Booking {
+	result := make([]*bookingProto.Booking, len(bookings))
+	for i, b := range bookings {
+		result[i] = &bookingProto.Booking{
+			Id:         int32(b.ID),
+			ResourceId: int32(b.ResourceID),
+			UserId:     int32(b.UserID),
+			StartTime:  timestamppb.New(b.StartTime),
+			EndTime:    timestamppb.New(b.EndTime),
+		}
+		if b.SeriesID != 0 {
+			result[i].SeriesId = proto.Int32(int32(b.SeriesID))
+		}
+	}
+	return result
+}
+
+func toProtoWindows(hours []bookingRepository.FacilityHours) []*bookingProto.WeeklyWindow {
+	result := make([]*bookingProto.WeeklyWindow, len(hours))
+	for i, h := range hours {
+		result[i] = &bookingProto.WeeklyWindow{
+			Weekday: int32(h.Weekday),
+			Start:   formatClock(h.Start),
+			End:     formatClock(h.End),
+		}
+	}
+	return result
+}
+
+// formatClock форматирует смещение от полуночи как HH:MM
+func formatClock(offset time.Duration) string {
+	minutes := int(offset / time.Minute)
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -3,7 +3,8 @@ package kitchenServer
 import (
 	"context"
 	kitchenProto "dormitory-helper-service/generated/proto/kitchen"
-	laundryRepository "dormitory-helper-service/internal/repository/laundry"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	bookingService "dormitory-helper-service/internal/service/booking"
 	grpcUtils "dormitory-helper-service/internal/utils/grpc"
 	"time"
 
@@ -14,28 +15,30 @@ import (
 
 type KitchenService interface {
 	CreateKitchenBooking(ctx context.Context, userID int, startTime, endTime time.Time) (int, error)
-	GetKitchenBookings(ctx context.Context, startTime, endTime *time.Time) ([]laundryRepository.Booking, error)
-	GetUserKitchenBookings(ctx context.Context, userID int) ([]laundryRepository.Booking, error)
+	GetKitchenBookings(ctx context.Context, startTime, endTime *time.Time) ([]bookingRepository.Booking, error)
+	GetUserKitchenBookings(ctx context.Context, userID int) ([]bookingRepository.Booking, error)
 	DeleteKitchenBooking(ctx context.Context, bookingID, userID int) error
+	UpdateKitchenBooking(ctx context.Context, bookingID, userID int, startTime, endTime *time.Time) (bookingRepository.Booking, error)
+	ExtendKitchenBooking(ctx context.Context, bookingID, userID, minutes int) (bookingRepository.Booking, error)
+	FindAvailableKitchenSlots(ctx context.Context, fromDate, toDate string, duration time.Duration, limit int) ([]bookingService.Slot, error)
+	FindNextKitchenSlot(ctx context.Context, duration time.Duration) (bookingService.Slot, error)
 }
 
 // Server implementation
 type Server struct {
 	kitchenProto.UnimplementedKitchenServiceServer
-	service   KitchenService
-	jwtSecret []byte
+	service KitchenService
 }
 
-func NewServer(service KitchenService, jwtSecret []byte) *Server {
+func NewServer(service KitchenService) *Server {
 	return &Server{
-		service:   service,
-		jwtSecret: jwtSecret,
+		service: service,
 	}
 }
 
 func (s *Server) CreateKitchenBooking(ctx context.Context, req *kitchenProto.CreateKitchenBookingRequest) (*kitchenProto.CreateKitchenBookingResponse, error) {
-	// Валидация JWT и получение user_id
-	userID, err := grpcUtils.ValidateTokenAndGetUserID(req.Token, s.jwtSecret)
+	// Получение user_id, проверенного интерцептором аутентификации
+	userID, err := grpcUtils.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +52,7 @@ func (s *Server) CreateKitchenBooking(ctx context.Context, req *kitchenProto.Cre
 
 	bookingID, err := s.service.CreateKitchenBooking(ctx, userID, startTime, endTime)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create kitchen booking: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to create kitchen booking")
 	}
 
 	return &kitchenProto.CreateKitchenBookingResponse{
@@ -71,7 +74,7 @@ func (s *Server) GetKitchenBookings(ctx context.Context, req *kitchenProto.GetKi
 
 	bookings, err := s.service.GetKitchenBookings(ctx, startTime, endTime)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get kitchen bookings: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to get kitchen bookings")
 	}
 
 	response := &kitchenProto.GetKitchenBookingsResponse{
@@ -90,16 +93,45 @@ func (s *Server) GetKitchenBookings(ctx context.Context, req *kitchenProto.GetKi
 	return response, nil
 }
 
+func (s *Server) FindAvailableKitchenSlots(ctx context.Context, req *kitchenProto.FindAvailableKitchenSlotsRequest) (*kitchenProto.FindAvailableKitchenSlotsResponse, error) {
+	slots, err := s.service.FindAvailableKitchenSlots(ctx, req.GetFromDate(), req.GetToDate(),
+		time.Duration(req.DurationMinutes)*time.Minute, int(req.GetLimit()))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find kitchen slots")
+	}
+
+	response := &kitchenProto.FindAvailableKitchenSlotsResponse{
+		Slots: make([]*kitchenProto.KitchenSlot, len(slots)),
+	}
+
+	for i, slot := range slots {
+		response.Slots[i] = toProtoSlot(slot)
+	}
+
+	return response, nil
+}
+
+func (s *Server) FindNextKitchenSlot(ctx context.Context, req *kitchenProto.FindNextKitchenSlotRequest) (*kitchenProto.FindNextKitchenSlotResponse, error) {
+	slot, err := s.service.FindNextKitchenSlot(ctx, time.Duration(req.DurationMinutes)*time.Minute)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find next kitchen slot")
+	}
+
+	return &kitchenProto.FindNextKitchenSlotResponse{
+		Slot: toProtoSlot(slot),
+	}, nil
+}
+
 func (s *Server) GetUserKitchenBookings(ctx context.Context, req *kitchenProto.GetUserKitchenBookingsRequest) (*kitchenProto.GetUserKitchenBookingsResponse, error) {
-	// Валидация JWT и получение user_id
-	userID, err := grpcUtils.ValidateTokenAndGetUserID(req.Token, s.jwtSecret)
+	// Получение user_id, проверенного интерцептором аутентификации
+	userID, err := grpcUtils.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	bookings, err := s.service.GetUserKitchenBookings(ctx, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user kitchen bookings: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to get user kitchen bookings")
 	}
 
 	response := &kitchenProto.GetUserKitchenBookingsResponse{
@@ -119,18 +151,83 @@ func (s *Server) GetUserKitchenBookings(ctx context.Context, req *kitchenProto.G
 }
 
 func (s *Server) DeleteKitchenBooking(ctx context.Context, req *kitchenProto.DeleteKitchenBookingRequest) (*kitchenProto.DeleteKitchenBookingResponse, error) {
-	// Валидация JWT и получение user_id
-	userID, err := grpcUtils.ValidateTokenAndGetUserID(req.Token, s.jwtSecret)
+	// Получение user_id, проверенного интерцептором аутентификации
+	userID, err := grpcUtils.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	err = s.service.DeleteKitchenBooking(ctx, int(req.BookingId), userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete kitchen booking: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to delete kitchen booking")
 	}
 
 	return &kitchenProto.DeleteKitchenBookingResponse{
 		Message: "Kitchen booking deleted successfully",
 	}, nil
 }
+
+func (s *Server) UpdateKitchenBooking(ctx context.Context, req *kitchenProto.UpdateKitchenBookingRequest) (*kitchenProto.UpdateKitchenBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartTime == nil && req.EndTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time or end_time is required")
+	}
+
+	var startTime, endTime *time.Time
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		startTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		endTime = &t
+	}
+
+	booking, err := s.service.UpdateKitchenBooking(ctx, int(req.BookingId), userID, startTime, endTime)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to update kitchen booking")
+	}
+
+	return &kitchenProto.UpdateKitchenBookingResponse{
+		Booking: &kitchenProto.KitchenBooking{
+			Id:        int32(booking.ID),
+			UserId:    int32(booking.UserID),
+			StartTime: timestamppb.New(booking.StartTime),
+			EndTime:   timestamppb.New(booking.EndTime),
+		},
+		Message: "Kitchen booking updated successfully",
+	}, nil
+}
+
+func (s *Server) ExtendKitchenBooking(ctx context.Context, req *kitchenProto.ExtendKitchenBookingRequest) (*kitchenProto.ExtendKitchenBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.service.ExtendKitchenBooking(ctx, int(req.BookingId), userID, int(req.Minutes))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to extend kitchen booking")
+	}
+
+	return &kitchenProto.ExtendKitchenBookingResponse{
+		Booking: &kitchenProto.KitchenBooking{
+			Id:        int32(booking.ID),
+			UserId:    int32(booking.UserID),
+			StartTime: timestamppb.New(booking.StartTime),
+			EndTime:   timestamppb.New(booking.EndTime),
+		},
+		Message: "Kitchen booking extended successfully",
+	}, nil
+}
+
+func toProtoSlot(slot bookingService.Slot) *kitchenProto.KitchenSlot {
+	return &kitchenProto.KitchenSlot{
+		StartTime: timestamppb.New(slot.StartTime),
+		EndTime:   timestamppb.New(slot.EndTime),
+	}
+}
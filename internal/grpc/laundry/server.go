@@ -3,7 +3,9 @@ package laundryServer
 import (
 	"context"
 	laundryProto "dormitory-helper-service/generated/proto/laundry"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
 	laundryRepository "dormitory-helper-service/internal/repository/laundry"
+	bookingService "dormitory-helper-service/internal/service/booking"
 	grpcUtils "dormitory-helper-service/internal/utils/grpc"
 	"time"
 
@@ -13,27 +15,56 @@ import (
 )
 
 type LaundryService interface {
-	CreateLaundryBooking(ctx context.Context, userID int, startTime, endTime time.Time) (int, error)
-	GetLaundryBookings(ctx context.Context, startTime, endTime *time.Time) ([]laundryRepository.Booking, error)
-	GetUserLaundryBookings(ctx context.Context, userID int) ([]laundryRepository.Booking, error)
+	ListMachines(ctx context.Context, machineType string) ([]laundryRepository.Machine, error)
+	CreateLaundryBooking(ctx context.Context, userID, machineID int, machineType string, startTime, endTime time.Time) (int, int, error)
+	GetLaundryBookings(ctx context.Context, machineID int, startTime, endTime *time.Time) ([]bookingRepository.Booking, error)
+	GetUserLaundryBookings(ctx context.Context, userID int) ([]bookingRepository.Booking, error)
 	DeleteLaundryBooking(ctx context.Context, bookingID, userID int) error
+	UpdateLaundryBooking(ctx context.Context, bookingID, userID, machineID int, startTime, endTime *time.Time) (bookingRepository.Booking, error)
+	ExtendLaundryBooking(ctx context.Context, bookingID, userID, minutes int) (bookingRepository.Booking, error)
+	CheckInLaundryBooking(ctx context.Context, bookingID, userID int, token string) (bookingRepository.Booking, error)
+	CheckInLaundryMachine(ctx context.Context, userID int, token string) (bookingRepository.Booking, error)
+	CheckOutLaundryBooking(ctx context.Context, bookingID, userID int) (bookingRepository.Booking, error)
+	FindAvailableLaundrySlots(ctx context.Context, machineID int, machineType, fromDate, toDate string, duration time.Duration, limit int) ([]bookingService.Slot, error)
+	FindNextLaundrySlot(ctx context.Context, machineID int, machineType string, duration time.Duration) (bookingService.Slot, error)
 }
 
 type Server struct {
 	laundryProto.UnimplementedLaundryServiceServer
-	service   LaundryService
-	jwtSecret []byte
+	service LaundryService
 }
 
-func NewServer(service LaundryService, jwtSecret []byte) *Server {
+func NewServer(service LaundryService) *Server {
 	return &Server{
-		service:   service,
-		jwtSecret: jwtSecret,
+		service: service,
 	}
 }
 
+func (s *Server) ListLaundryMachines(ctx context.Context, req *laundryProto.ListLaundryMachinesRequest) (*laundryProto.ListLaundryMachinesResponse, error) {
+	machines, err := s.service.ListMachines(ctx, machineTypeFromProto(req.Type))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to list laundry machines")
+	}
+
+	response := &laundryProto.ListLaundryMachinesResponse{
+		Machines: make([]*laundryProto.LaundryMachine, len(machines)),
+	}
+
+	for i, m := range machines {
+		response.Machines[i] = &laundryProto.LaundryMachine{
+			Id:     int32(m.ID),
+			Name:   m.Name,
+			Type:   machineTypeToProto(m.Type),
+			Floor:  int32(m.Floor),
+			Status: machineStatusToProto(m.Status),
+		}
+	}
+
+	return response, nil
+}
+
 func (s *Server) CreateLaundryBooking(ctx context.Context, req *laundryProto.CreateLaundryBookingRequest) (*laundryProto.CreateLaundryBookingResponse, error) {
-	userID, err := grpcUtils.ValidateTokenAndGetUserID(req.Token, s.jwtSecret)
+	userID, err := grpcUtils.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -45,14 +76,15 @@ func (s *Server) CreateLaundryBooking(ctx context.Context, req *laundryProto.Cre
 	startTime := req.StartTime.AsTime()
 	endTime := req.EndTime.AsTime()
 
-	bookingID, err := s.service.CreateLaundryBooking(ctx, userID, startTime, endTime)
+	bookingID, machineID, err := s.service.CreateLaundryBooking(ctx, userID, int(req.GetMachineId()), machineTypeFromProto(req.MachineType), startTime, endTime)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create laundry booking: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to create laundry booking")
 	}
 
 	return &laundryProto.CreateLaundryBookingResponse{
 		BookingId: int32(bookingID),
 		Message:   "Laundry booking created successfully",
+		MachineId: int32(machineID),
 	}, nil
 }
 
@@ -67,9 +99,9 @@ func (s *Server) GetLaundryBookings(ctx context.Context, req *laundryProto.GetLa
 		endTime = &t
 	}
 
-	bookings, err := s.service.GetLaundryBookings(ctx, startTime, endTime)
+	bookings, err := s.service.GetLaundryBookings(ctx, int(req.GetMachineId()), startTime, endTime)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get laundry bookings: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to get laundry bookings")
 	}
 
 	response := &laundryProto.GetLaundryBookingsResponse{
@@ -77,26 +109,51 @@ func (s *Server) GetLaundryBookings(ctx context.Context, req *laundryProto.GetLa
 	}
 
 	for i, b := range bookings {
-		response.Bookings[i] = &laundryProto.LaundryBooking{
-			Id:        int32(b.ID),
-			UserId:    int32(b.UserID),
-			StartTime: timestamppb.New(b.StartTime),
-			EndTime:   timestamppb.New(b.EndTime),
-		}
+		response.Bookings[i] = toProtoBooking(b)
+	}
+
+	return response, nil
+}
+
+func (s *Server) FindAvailableLaundrySlots(ctx context.Context, req *laundryProto.FindAvailableLaundrySlotsRequest) (*laundryProto.FindAvailableLaundrySlotsResponse, error) {
+	slots, err := s.service.FindAvailableLaundrySlots(ctx, int(req.GetMachineId()), machineTypeFromProto(req.MachineType),
+		req.GetFromDate(), req.GetToDate(), time.Duration(req.DurationMinutes)*time.Minute, int(req.GetLimit()))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find laundry slots")
+	}
+
+	response := &laundryProto.FindAvailableLaundrySlotsResponse{
+		Slots: make([]*laundryProto.LaundrySlot, len(slots)),
+	}
+
+	for i, slot := range slots {
+		response.Slots[i] = toProtoSlot(slot)
 	}
 
 	return response, nil
 }
 
+func (s *Server) FindNextLaundrySlot(ctx context.Context, req *laundryProto.FindNextLaundrySlotRequest) (*laundryProto.FindNextLaundrySlotResponse, error) {
+	slot, err := s.service.FindNextLaundrySlot(ctx, int(req.GetMachineId()), machineTypeFromProto(req.MachineType),
+		time.Duration(req.DurationMinutes)*time.Minute)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to find next laundry slot")
+	}
+
+	return &laundryProto.FindNextLaundrySlotResponse{
+		Slot: toProtoSlot(slot),
+	}, nil
+}
+
 func (s *Server) GetUserLaundryBookings(ctx context.Context, req *laundryProto.GetUserLaundryBookingsRequest) (*laundryProto.GetUserLaundryBookingsResponse, error) {
-	userID, err := grpcUtils.ValidateTokenAndGetUserID(req.Token, s.jwtSecret)
+	userID, err := grpcUtils.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	bookings, err := s.service.GetUserLaundryBookings(ctx, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user laundry bookings: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to get user laundry bookings")
 	}
 
 	response := &laundryProto.GetUserLaundryBookingsResponse{
@@ -104,29 +161,185 @@ func (s *Server) GetUserLaundryBookings(ctx context.Context, req *laundryProto.G
 	}
 
 	for i, b := range bookings {
-		response.Bookings[i] = &laundryProto.LaundryBooking{
-			Id:        int32(b.ID),
-			UserId:    int32(b.UserID),
-			StartTime: timestamppb.New(b.StartTime),
-			EndTime:   timestamppb.New(b.EndTime),
-		}
+		response.Bookings[i] = toProtoBooking(b)
 	}
 
 	return response, nil
 }
 
 func (s *Server) DeleteLaundryBooking(ctx context.Context, req *laundryProto.DeleteLaundryBookingRequest) (*laundryProto.DeleteLaundryBookingResponse, error) {
-	userID, err := grpcUtils.ValidateTokenAndGetUserID(req.Token, s.jwtSecret)
+	userID, err := grpcUtils.UserIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	err = s.service.DeleteLaundryBooking(ctx, int(req.BookingId), userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete laundry booking: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to delete laundry booking")
 	}
 
 	return &laundryProto.DeleteLaundryBookingResponse{
 		Message: "Laundry booking deleted successfully",
 	}, nil
 }
+
+func (s *Server) UpdateLaundryBooking(ctx context.Context, req *laundryProto.UpdateLaundryBookingRequest) (*laundryProto.UpdateLaundryBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartTime == nil && req.EndTime == nil && req.MachineId == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time, end_time or machine_id is required")
+	}
+
+	var startTime, endTime *time.Time
+	if req.StartTime != nil {
+		t := req.StartTime.AsTime()
+		startTime = &t
+	}
+	if req.EndTime != nil {
+		t := req.EndTime.AsTime()
+		endTime = &t
+	}
+
+	booking, err := s.service.UpdateLaundryBooking(ctx, int(req.BookingId), userID, int(req.GetMachineId()), startTime, endTime)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to update laundry booking")
+	}
+
+	return &laundryProto.UpdateLaundryBookingResponse{
+		Booking: toProtoBooking(booking),
+		Message: "Laundry booking updated successfully",
+	}, nil
+}
+
+func (s *Server) ExtendLaundryBooking(ctx context.Context, req *laundryProto.ExtendLaundryBookingRequest) (*laundryProto.ExtendLaundryBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.service.ExtendLaundryBooking(ctx, int(req.BookingId), userID, int(req.Minutes))
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to extend laundry booking")
+	}
+
+	return &laundryProto.ExtendLaundryBookingResponse{
+		Booking: toProtoBooking(booking),
+		Message: "Laundry booking extended successfully",
+	}, nil
+}
+
+func (s *Server) CheckInLaundryBooking(ctx context.Context, req *laundryProto.CheckInLaundryBookingRequest) (*laundryProto.CheckInLaundryBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.service.CheckInLaundryBooking(ctx, int(req.BookingId), userID, req.GetCheckInToken())
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to check in laundry booking")
+	}
+
+	return &laundryProto.CheckInLaundryBookingResponse{
+		Booking: toProtoBooking(booking),
+		Message: "Checked in successfully",
+	}, nil
+}
+
+func (s *Server) CheckInLaundryMachine(ctx context.Context, req *laundryProto.CheckInLaundryMachineRequest) (*laundryProto.CheckInLaundryMachineResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CheckInToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "check_in_token is required")
+	}
+
+	booking, err := s.service.CheckInLaundryMachine(ctx, userID, req.CheckInToken)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to check in laundry machine")
+	}
+
+	return &laundryProto.CheckInLaundryMachineResponse{
+		Booking: toProtoBooking(booking),
+		Message: "Checked in successfully",
+	}, nil
+}
+
+func (s *Server) CheckOutLaundryBooking(ctx context.Context, req *laundryProto.CheckOutLaundryBookingRequest) (*laundryProto.CheckOutLaundryBookingResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.service.CheckOutLaundryBooking(ctx, int(req.BookingId), userID)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to check out laundry booking")
+	}
+
+	return &laundryProto.CheckOutLaundryBookingResponse{
+		Booking: toProtoBooking(booking),
+		Message: "Checked out successfully",
+	}, nil
+}
+
+func toProtoBooking(b bookingRepository.Booking) *laundryProto.LaundryBooking {
+	booking := &laundryProto.LaundryBooking{
+		Id:        int32(b.ID),
+		UserId:    int32(b.UserID),
+		StartTime: timestamppb.New(b.StartTime),
+		EndTime:   timestamppb.New(b.EndTime),
+		MachineId: int32(b.ResourceID),
+	}
+	if b.CheckedInAt != nil {
+		booking.CheckedInAt = timestamppb.New(*b.CheckedInAt)
+	}
+	if b.CheckedOutAt != nil {
+		booking.CheckedOutAt = timestamppb.New(*b.CheckedOutAt)
+	}
+	return booking
+}
+
+func toProtoSlot(slot bookingService.Slot) *laundryProto.LaundrySlot {
+	return &laundryProto.LaundrySlot{
+		MachineId: int32(slot.ResourceID),
+		StartTime: timestamppb.New(slot.StartTime),
+		EndTime:   timestamppb.New(slot.EndTime),
+	}
+}
+
+func machineTypeFromProto(t laundryProto.MachineType) string {
+	switch t {
+	case laundryProto.MachineType_MACHINE_TYPE_WASHER:
+		return laundryRepository.MachineTypeWasher
+	case laundryProto.MachineType_MACHINE_TYPE_DRYER:
+		return laundryRepository.MachineTypeDryer
+	default:
+		return ""
+	}
+}
+
+func machineTypeToProto(t string) laundryProto.MachineType {
+	switch t {
+	case laundryRepository.MachineTypeWasher:
+		return laundryProto.MachineType_MACHINE_TYPE_WASHER
+	case laundryRepository.MachineTypeDryer:
+		return laundryProto.MachineType_MACHINE_TYPE_DRYER
+	default:
+		return laundryProto.MachineType_MACHINE_TYPE_UNSPECIFIED
+	}
+}
+
+func machineStatusToProto(s string) laundryProto.MachineStatus {
+	switch s {
+	case laundryRepository.MachineStatusAvailable:
+		return laundryProto.MachineStatus_MACHINE_STATUS_AVAILABLE
+	case laundryRepository.MachineStatusOutOfOrder:
+		return laundryProto.MachineStatus_MACHINE_STATUS_OUT_OF_ORDER
+	default:
+		return laundryProto.MachineStatus_MACHINE_STATUS_UNSPECIFIED
+	}
+}
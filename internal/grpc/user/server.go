@@ -3,13 +3,11 @@ package userServer
 import (
 	"context"
 	userGrpcModels "dormitory-helper-service/generated/proto/user"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	grpcUtils "dormitory-helper-service/internal/utils/grpc"
 )
 
 type UserService interface {
-	CheckAuthentication(ctx context.Context, token string) (userId int, username string, resultToken string, err error)
+	CheckAuthentication(ctx context.Context, token string) (userId int, username string, resultToken string, role string, err error)
 }
 
 type Server struct {
@@ -25,10 +23,16 @@ func NewServer(service UserService) *Server {
 }
 
 func (s *Server) CheckAuthentication(ctx context.Context, req *userGrpcModels.CheckAuthenticationRequest) (*userGrpcModels.CheckAuthenticationResponse, error) {
+	// Токен из заголовка Authorization имеет приоритет над полем в теле запроса
+	token := grpcUtils.BearerTokenFromContext(ctx)
+	if token == "" {
+		token = req.Token
+	}
+
 	// Вызываем сервис для проверки аутентификации
-	userId, username, token, err := s.service.CheckAuthentication(ctx, req.Token)
+	userId, username, token, role, err := s.service.CheckAuthentication(ctx, token)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check authentication: %v", err)
+		return nil, grpcUtils.StatusFromError(err, "failed to check authentication")
 	}
 
 	// Формируем ответ
@@ -36,5 +40,6 @@ func (s *Server) CheckAuthentication(ctx context.Context, req *userGrpcModels.Ch
 		UserId:   int32(userId),
 		Username: username,
 		Token:    token,
+		Role:     role,
 	}, nil
 }
@@ -0,0 +1,153 @@
+package waitlistServer
+
+import (
+	"context"
+	waitlistProto "dormitory-helper-service/generated/proto/waitlist"
+	waitlistRepository "dormitory-helper-service/internal/repository/waitlist"
+	grpcUtils "dormitory-helper-service/internal/utils/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WaitlistService interface {
+	JoinWaitlist(ctx context.Context, entry waitlistRepository.Entry) (int, error)
+	GetMyWaitlist(ctx context.Context, userID int) ([]waitlistRepository.Entry, error)
+	LeaveWaitlist(ctx context.Context, entryID, userID int) error
+	ConfirmWaitlistHold(ctx context.Context, entryID, userID int) (int, error)
+}
+
+type Server struct {
+	waitlistProto.UnimplementedWaitlistServiceServer
+	service WaitlistService
+}
+
+func NewServer(service WaitlistService) *Server {
+	return &Server{
+		service: service,
+	}
+}
+
+func (s *Server) JoinWaitlist(ctx context.Context, req *waitlistProto.JoinWaitlistRequest) (*waitlistProto.JoinWaitlistResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.WindowStart == nil || req.WindowEnd == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "window_start and window_end are required")
+	}
+	if req.ResourceType == "" && req.ResourceId == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_type or resource_id is required")
+	}
+
+	entryID, err := s.service.JoinWaitlist(ctx, waitlistRepository.Entry{
+		UserID:          userID,
+		ResourceType:    req.ResourceType,
+		ResourceID:      int(req.GetResourceId()),
+		WindowStart:     req.WindowStart.AsTime(),
+		WindowEnd:       req.WindowEnd.AsTime(),
+		DurationMinutes: int(req.GetDurationMinutes()),
+		AutoBook:        req.AutoBook,
+	})
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to join waitlist")
+	}
+
+	return &waitlistProto.JoinWaitlistResponse{
+		EntryId: int32(entryID),
+		Message: "Added to the waitlist",
+	}, nil
+}
+
+func (s *Server) GetMyWaitlist(ctx context.Context, req *waitlistProto.GetMyWaitlistRequest) (*waitlistProto.GetMyWaitlistResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.service.GetMyWaitlist(ctx, userID)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to get waitlist")
+	}
+
+	response := &waitlistProto.GetMyWaitlistResponse{
+		Entries: make([]*waitlistProto.WaitlistEntry, len(entries)),
+	}
+
+	for i, e := range entries {
+		entry := &waitlistProto.WaitlistEntry{
+			Id:              int32(e.ID),
+			ResourceType:    e.ResourceType,
+			WindowStart:     timestamppb.New(e.WindowStart),
+			WindowEnd:       timestamppb.New(e.WindowEnd),
+			DurationMinutes: int32(e.DurationMinutes),
+			AutoBook:        e.AutoBook,
+			Status:          toProtoStatus(e.Status),
+			CreatedAt:       timestamppb.New(e.CreatedAt),
+		}
+		if e.ResourceID != 0 {
+			entry.ResourceId = proto.Int32(int32(e.ResourceID))
+		}
+		if e.BookingID != 0 {
+			entry.BookingId = proto.Int32(int32(e.BookingID))
+		}
+		if e.HoldUntil != nil {
+			entry.HoldUntil = timestamppb.New(*e.HoldUntil)
+		}
+		response.Entries[i] = entry
+	}
+
+	return response, nil
+}
+
+func (s *Server) LeaveWaitlist(ctx context.Context, req *waitlistProto.LeaveWaitlistRequest) (*waitlistProto.LeaveWaitlistResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.LeaveWaitlist(ctx, int(req.EntryId), userID); err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to leave waitlist")
+	}
+
+	return &waitlistProto.LeaveWaitlistResponse{
+		Message: "Removed from the waitlist",
+	}, nil
+}
+
+func (s *Server) ConfirmWaitlistHold(ctx context.Context, req *waitlistProto.ConfirmWaitlistHoldRequest) (*waitlistProto.ConfirmWaitlistHoldResponse, error) {
+	userID, err := grpcUtils.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bookingID, err := s.service.ConfirmWaitlistHold(ctx, int(req.EntryId), userID)
+	if err != nil {
+		return nil, grpcUtils.StatusFromError(err, "failed to confirm waitlist hold")
+	}
+
+	return &waitlistProto.ConfirmWaitlistHoldResponse{
+		BookingId: int32(bookingID),
+		Message:   "Booking confirmed",
+	}, nil
+}
+
+func toProtoStatus(status string) waitlistProto.WaitlistStatus {
+	switch status {
+	case waitlistRepository.StatusWaiting:
+		return waitlistProto.WaitlistStatus_WAITLIST_STATUS_WAITING
+	case waitlistRepository.StatusHeld:
+		return waitlistProto.WaitlistStatus_WAITLIST_STATUS_HELD
+	case waitlistRepository.StatusBooked:
+		return waitlistProto.WaitlistStatus_WAITLIST_STATUS_BOOKED
+	case waitlistRepository.StatusExpired:
+		return waitlistProto.WaitlistStatus_WAITLIST_STATUS_EXPIRED
+	case waitlistRepository.StatusCancelled:
+		return waitlistProto.WaitlistStatus_WAITLIST_STATUS_CANCELLED
+	default:
+		return waitlistProto.WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+	}
+}
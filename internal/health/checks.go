@@ -0,0 +1,18 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DatabaseCheck проверяет, что пул может выдать соединение и база отвечает
+func DatabaseCheck(db *pgxpool.Pool) Check {
+	return func(ctx context.Context) error {
+		if err := db.Ping(ctx); err != nil {
+			return fmt.Errorf("database is unreachable: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,185 @@
+package health
+
+import (
+	"context"
+	"dormitory-helper-service/internal/logging"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout ограничение времени одной проверки
+const checkTimeout = 2 * time.Second
+
+// Check проверка одной зависимости. nil означает, что зависимость исправна
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Report результат проверок
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+func (r Report) OK() bool {
+	return r.Status == statusOK
+}
+
+const (
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+// Checker собирает проверки живости (liveness) и готовности (readiness).
+// Готовность дополнительно публикуется через стандартный grpc.health.v1
+type Checker struct {
+	grpcHealth *health.Server
+
+	mu        sync.Mutex
+	liveness  []namedCheck
+	readiness []namedCheck
+
+	shuttingDown atomic.Bool
+}
+
+func NewChecker() *Checker {
+	grpcHealth := health.NewServer()
+	grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &Checker{
+		grpcHealth: grpcHealth,
+	}
+}
+
+// GRPCServer возвращает реализацию grpc.health.v1 для регистрации на gRPC сервере
+func (c *Checker) GRPCServer() *health.Server {
+	return c.grpcHealth
+}
+
+// AddLivenessCheck добавляет проверку, провал которой означает, что процесс нужно перезапустить
+func (c *Checker) AddLivenessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.liveness = append(c.liveness, namedCheck{name: name, check: check})
+}
+
+// AddReadinessCheck добавляет проверку, провал которой означает, что трафик
+// на реплику направлять нельзя
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.readiness = append(c.readiness, namedCheck{name: name, check: check})
+}
+
+// Live выполняет проверки живости
+func (c *Checker) Live(ctx context.Context) Report {
+	c.mu.Lock()
+	checks := c.liveness
+	c.mu.Unlock()
+
+	return run(ctx, checks)
+}
+
+// Ready выполняет проверки готовности. Во время остановки реплика не готова
+func (c *Checker) Ready(ctx context.Context) Report {
+	if c.shuttingDown.Load() {
+		return Report{Status: statusFail, Checks: map[string]string{"shutdown": "shutting down"}}
+	}
+
+	c.mu.Lock()
+	checks := c.readiness
+	c.mu.Unlock()
+
+	return run(ctx, checks)
+}
+
+// Shutdown переводит реплику в состояние "не готова" перед остановкой серверов
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+	c.grpcHealth.Shutdown()
+}
+
+// Watch периодически выполняет проверки готовности и обновляет статус grpc.health.v1
+// до отмены ctx
+func (c *Checker) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.updateServingStatus(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) updateServingStatus(ctx context.Context) {
+	if c.shuttingDown.Load() {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if report := c.Ready(ctx); !report.OK() {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.grpcHealth.SetServingStatus("", status)
+}
+
+// LivenessHandler HTTP обработчик для /healthz
+func (c *Checker) LivenessHandler(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeReport(w, c.Live(r.Context()))
+}
+
+// ReadinessHandler HTTP обработчик для /readyz
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	writeReport(w, c.Ready(r.Context()))
+}
+
+func run(ctx context.Context, checks []namedCheck) Report {
+	report := Report{Status: statusOK, Checks: make(map[string]string, len(checks))}
+
+	for _, nc := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := nc.check(checkCtx)
+		cancel()
+
+		if err != nil {
+			report.Status = statusFail
+			report.Checks[nc.name] = err.Error()
+			continue
+		}
+		report.Checks[nc.name] = statusOK
+	}
+
+	return report
+}
+
+func writeReport(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	if report.OK() {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		slog.Error("failed to write health report", logging.Err(err))
+	}
+}
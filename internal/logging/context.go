@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+)
+
+// RequestIDHeader заголовок с идентификатором запроса
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength ограничение длины идентификатора, пришедшего от клиента
+const maxRequestIDLength = 128
+
+// requestAttrs атрибуты запроса. Хранятся по указателю, чтобы атрибуты,
+// добавленные глубже по цепочке (например, user_id после аутентификации),
+// попадали и в записи внешних middleware
+type requestAttrs struct {
+	mu    sync.Mutex
+	attrs []slog.Attr
+}
+
+type requestAttrsKey struct{}
+
+type requestIDKey struct{}
+
+// NewRequestContext начинает контекст запроса с идентификатором requestID
+func NewRequestContext(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	ctx = context.WithValue(ctx, requestAttrsKey{}, &requestAttrs{})
+	AddAttrs(ctx, slog.String("request_id", requestID))
+	return ctx
+}
+
+// AddAttrs добавляет атрибуты ко всем последующим записям запроса
+func AddAttrs(ctx context.Context, attrs ...slog.Attr) {
+	ra, ok := ctx.Value(requestAttrsKey{}).(*requestAttrs)
+	if !ok {
+		return
+	}
+
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+	ra.attrs = append(ra.attrs, attrs...)
+}
+
+// RequestIDFromContext возвращает идентификатор запроса
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func attrsFromContext(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	ra, ok := ctx.Value(requestAttrsKey{}).(*requestAttrs)
+	if !ok {
+		return nil
+	}
+
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+	return append([]slog.Attr(nil), ra.attrs...)
+}
+
+// RequestIDOrNew возвращает идентификатор от клиента, если он допустим, иначе генерирует новый
+func RequestIDOrNew(requestID string) string {
+	if requestID != "" && len(requestID) <= maxRequestIDLength && isPrintableASCII(requestID) {
+		return requestID
+	}
+	return NewRequestID()
+}
+
+// NewRequestID генерирует случайный идентификатор запроса
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func isPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x21 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dormitory"
+
+// Registry реестр метрик сервиса, отдается на /metrics
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Метрики запросов
+var (
+	GRPCRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC calls by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	HTTPRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+)
+
+// Доменные метрики
+var (
+	BookingsCreated = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "bookings",
+		Name:      "created_total",
+		Help:      "Bookings created per resource.",
+	}, []string{"resource_type", "resource_id"})
+
+	BookingConflicts = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "bookings",
+		Name:      "conflicts_total",
+		Help:      "Booking attempts rejected because the time slot was taken, per resource.",
+	}, []string{"resource_type", "resource_id"})
+
+	BookingsUpdated = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "bookings",
+		Name:      "updated_total",
+		Help:      "Bookings moved, resized or extended by their owners, by resource type.",
+	}, []string{"resource_type"})
+
+	BookingsDeleted = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "bookings",
+		Name:      "deleted_total",
+		Help:      "Bookings deleted by resource type and reason (cancelled, admin, blackout, hold_released, no_show).",
+	}, []string{"resource_type", "reason"})
+
+	WaitlistPromotions = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "waitlist",
+		Name:      "promotions_total",
+		Help:      "Waitlist entries that received freed time, by resource type and status (booked, held).",
+	}, []string{"resource_type", "status"})
+
+	BookingsPurged = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "bookings",
+		Name:      "purged_total",
+		Help:      "Past bookings removed by the retention job.",
+	})
+
+	UsersCreated = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "users",
+		Name:      "created_total",
+		Help:      "Users created.",
+	})
+
+	UsersExpired = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "users",
+		Name:      "expired_total",
+		Help:      "Users deleted after their time to live expired.",
+	})
+)
+
+// Причины удаления записей для BookingsDeleted
+const (
+	DeleteReasonCancelled = "cancelled"
+	DeleteReasonAdmin     = "admin"
+	DeleteReasonBlackout  = "blackout"
+	// DeleteReasonHoldReleased запись из листа ожидания не подтверждена или отменена
+	DeleteReasonHoldReleased = "hold_released"
+	// DeleteReasonNoShow пользователь не отметился о приходе вовремя
+	DeleteReasonNoShow = "no_show"
+)
+
+// Handler HTTP обработчик для /metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,110 @@
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"dormitory-helper-service/migrations"
+	"fmt"
+	"io"
+	"log/slog"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// Migrator применяет встроенные миграции. Все реплики и CLI берут один и тот же
+// advisory lock, поэтому миграции не выполняются параллельно
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func New(db *sql.DB) (*Migrator, error) {
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS,
+		goose.WithSessionLocker(locker),
+		goose.WithSlog(slog.Default()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+// Up применяет все недостающие миграции
+func (m *Migrator) Up(ctx context.Context) error {
+	results, err := m.provider.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	for _, r := range results {
+		slog.InfoContext(ctx, "migration applied",
+			slog.Int64("version", r.Source.Version),
+			slog.Duration("duration", r.Duration),
+		)
+	}
+	return nil
+}
+
+// Down откатывает последнюю примененную миграцию
+func (m *Migrator) Down(ctx context.Context) error {
+	result, err := m.provider.Down(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to roll back migration: %w", err)
+	}
+
+	slog.InfoContext(ctx, "migration rolled back",
+		slog.Int64("version", result.Source.Version),
+		slog.Duration("duration", result.Duration),
+	)
+	return nil
+}
+
+// Versions возвращает текущую версию схемы и версию последней встроенной миграции
+func (m *Migrator) Versions(ctx context.Context) (current, latest int64, err error) {
+	current, latest, err = m.provider.GetVersions(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get migration versions: %w", err)
+	}
+	return current, latest, nil
+}
+
+// WriteStatus печатает состояние каждой миграции
+func (m *Migrator) WriteStatus(ctx context.Context, w io.Writer) error {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get migration status: %w", err)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tSTATE\tAPPLIED AT\tFILE")
+	for _, s := range statuses {
+		appliedAt := "-"
+		if !s.AppliedAt.IsZero() {
+			appliedAt = s.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", s.Source.Version, s.State, appliedAt, s.Source.Path)
+	}
+	return tw.Flush()
+}
+
+// CheckSchema возвращает ошибку, если в базе применены не все встроенные миграции.
+// Более новая схема допустима: ее могла применить следующая версия сервиса при выкатке
+func (m *Migrator) CheckSchema(ctx context.Context) error {
+	current, latest, err := m.Versions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current < latest {
+		return fmt.Errorf("database schema version %d is behind expected %d", current, latest)
+	}
+	return nil
+}
@@ -0,0 +1,1095 @@
+package bookingRepository
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// exclusionViolationCode код ошибки PostgreSQL exclusion_violation
+const exclusionViolationCode = "23P01"
+
+// ErrTimeSlotBooked возвращается, если выбранное время на ресурсе уже занято
+var ErrTimeSlotBooked = domainErrors.Conflict("TIME_SLOT_BOOKED", "time slot is already booked")
+
+// ReasonBlackedOut причина ошибки, если ресурс закрыт администратором на выбранное время
+const ReasonBlackedOut = "RESOURCE_BLACKED_OUT"
+
+// ReasonOutOfOrder причина ошибки, если ресурс (машина прачечной) неисправен
+const ReasonOutOfOrder = "MACHINE_OUT_OF_ORDER"
+
+type Repository struct{}
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+// Rules дополнительные правила бронирования ресурса (хранятся в resources.rules)
+type Rules struct {
+	MinDurationMinutes int `json:"min_duration_minutes,omitempty"`
+	SlotStepMinutes    int `json:"slot_step_minutes,omitempty"`
+	// CheckInGraceMinutes через сколько минут после начала освобождается запись без отметки
+	// о приходе. 0 - отмечаться не нужно
+	CheckInGraceMinutes int `json:"check_in_grace_minutes,omitempty"`
+}
+
+type Resource struct {
+	ID                 int
+	Type               string
+	Name               string
+	MaxDurationMinutes int
+	Capacity           int
+	Rules              Rules
+	IsActive           bool
+}
+
+type Booking struct {
+	ID         int
+	ResourceID int
+	UserID     int
+	StartTime  time.Time
+	EndTime    time.Time
+	// SeriesID серия повторяющихся записей. 0 - одиночная запись
+	SeriesID     int
+	CheckedInAt  *time.Time
+	CheckedOutAt *time.Time
+}
+
+// BookingDetails запись с данными ресурса и владельца
+type BookingDetails struct {
+	Booking
+	ResourceType string
+	ResourceName string
+	Username     string
+}
+
+// Ban запрет пользователю на бронирование. BannedUntil = nil - бессрочно,
+// CreatedBy = 0 - запрет назначен автоматически (например, за неявки)
+type Ban struct {
+	UserID      int
+	Reason      string
+	BannedUntil *time.Time
+	CreatedBy   int
+}
+
+// BookingFilter условия выборки записей. Пустые поля не участвуют в фильтрации
+type BookingFilter struct {
+	ResourceID   int
+	ResourceType string
+	UserID       int
+	StartTime    *time.Time
+	EndTime      *time.Time
+	// StartBefore записи, начинающиеся раньше этого момента (например, до конца дня)
+	StartBefore *time.Time
+	// EndAfter записи, заканчивающиеся позже этого момента. Вместе со StartBefore
+	// выбирает записи, пересекающие промежуток
+	EndAfter *time.Time
+	SeriesID int
+}
+
+const resourceColumns = `id, type, name, max_duration_minutes, capacity, rules, is_active`
+
+func scanResource(row pgx.Row) (Resource, error) {
+	var r Resource
+	err := row.Scan(&r.ID, &r.Type, &r.Name, &r.MaxDurationMinutes, &r.Capacity, &r.Rules, &r.IsActive)
+	return r, err
+}
+
+// GetResources возвращает активные ресурсы. Если resourceType пустой - ресурсы всех типов
+func (r *Repository) GetResources(ctx context.Context, conn *pgx.Conn, resourceType string) ([]Resource, error) {
+	query := `SELECT ` + resourceColumns + ` FROM resources WHERE is_active`
+	args := []interface{}{}
+
+	if resourceType != "" {
+		query += ` AND type = $1`
+		args = append(args, resourceType)
+	}
+
+	query += ` ORDER BY id`
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query resources: %w", err)
+	}
+	defer rows.Close()
+
+	var resources []Resource
+	for rows.Next() {
+		res, err := scanResource(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan resource: %w", err)
+		}
+		resources = append(resources, res)
+	}
+
+	return resources, rows.Err()
+}
+
+// GetResourceByID возвращает ресурс по ID
+func (r *Repository) GetResourceByID(ctx context.Context, conn *pgx.Conn, resourceID int) (Resource, error) {
+	res, err := scanResource(conn.QueryRow(ctx, `
+		SELECT `+resourceColumns+` FROM resources WHERE id = $1
+	`, resourceID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Resource{}, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("resource %d not found", resourceID))
+		}
+		return Resource{}, fmt.Errorf("failed to get resource %d: %w", resourceID, err)
+	}
+	return res, nil
+}
+
+// GetResourceForShare возвращает ресурс по ID, блокируя его строку FOR SHARE
+// до конца транзакции, чтобы ресурс не отключили во время записи
+func (r *Repository) GetResourceForShare(ctx context.Context, conn *pgx.Conn, resourceID int) (Resource, error) {
+	res, err := scanResource(conn.QueryRow(ctx, `
+		SELECT `+resourceColumns+` FROM resources WHERE id = $1 FOR SHARE
+	`, resourceID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Resource{}, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("resource %d not found", resourceID))
+		}
+		return Resource{}, fmt.Errorf("failed to lock resource %d: %w", resourceID, err)
+	}
+	return res, nil
+}
+
+// CreateBooking создает запись на ресурс с учетом его вместимости.
+// Запись занимает первую свободную единицу ресурса (unit). Пересечения в рамках
+// одной единицы запрещены ограничением ex_bookings_no_overlap, поэтому
+// конкурентные запросы на одно время не могут создать двойную запись.
+// Если конкурентный запрос занял ту же единицу, а у ресурса их несколько,
+// вставка повторяется в точке сохранения: следующая попытка увидит
+// зафиксированную запись и выберет другую единицу. Вызывается в транзакции
+func (r *Repository) CreateBooking(ctx context.Context, conn *pgx.Conn, resource Resource, userID int, startTime, endTime time.Time) (int, error) {
+	for attempt := 1; ; attempt++ {
+		retry := attempt < resource.Capacity
+
+		bookingID, err := r.insertBooking(ctx, conn, resource, userID, startTime, endTime, retry)
+		if err == nil {
+			return bookingID, nil
+		}
+		if retry && isExclusionViolation(err) {
+			continue
+		}
+		if err == pgx.ErrNoRows || isExclusionViolation(err) {
+			return 0, ErrTimeSlotBooked
+		}
+		return 0, err
+	}
+}
+
+// insertBooking вставляет запись на первую свободную единицу ресурса. Если savepoint
+// равен true, вставка выполняется в точке сохранения, чтобы после нарушения
+// ограничения транзакцию можно было продолжить
+func (r *Repository) insertBooking(ctx context.Context, conn *pgx.Conn, resource Resource, userID int, startTime, endTime time.Time, savepoint bool) (int, error) {
+	if savepoint {
+		if _, err := conn.Exec(ctx, `SAVEPOINT create_booking`); err != nil {
+			return 0, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+	}
+
+	var bookingID int
+	err := conn.QueryRow(ctx, `
+		INSERT INTO bookings (resource_id, user_id, start_time, end_time, unit)
+		SELECT $1, $2, $3::timestamptz, $4::timestamptz, u.unit
+		FROM generate_series(1, $5::int) AS u (unit)
+		WHERE NOT EXISTS (
+			SELECT 1 FROM bookings b
+			WHERE b.resource_id = $1 AND b.unit = u.unit
+				AND b.period && tstzrange($3::timestamptz, $4::timestamptz, '[)')
+		)
+		ORDER BY u.unit
+		LIMIT 1
+		RETURNING id
+	`, resource.ID, userID, startTime, endTime, resource.Capacity).Scan(&bookingID)
+	if err != nil && err != pgx.ErrNoRows && !isExclusionViolation(err) {
+		return 0, fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	if savepoint {
+		command := `RELEASE SAVEPOINT create_booking`
+		if isExclusionViolation(err) {
+			command = `ROLLBACK TO SAVEPOINT create_booking`
+		}
+		if _, spErr := conn.Exec(ctx, command); spErr != nil {
+			return 0, fmt.Errorf("failed to finish savepoint: %w", spErr)
+		}
+	}
+
+	return bookingID, err
+}
+
+// isExclusionViolation проверяет, что ошибка вызвана нарушением ограничения EXCLUDE (23P01)
+func isExclusionViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == exclusionViolationCode
+}
+
+// GetBookings получает записи, удовлетворяющие фильтру
+func (r *Repository) GetBookings(ctx context.Context, conn *pgx.Conn, filter BookingFilter) ([]Booking, error) {
+	where, args := bookingConditions(filter)
+	query := `
+		SELECT b.id, b.resource_id, b.user_id, b.start_time, b.end_time, COALESCE(b.series_id, 0),
+			b.checked_in_at, b.checked_out_at
+		FROM bookings b
+		JOIN resources r ON r.id = b.resource_id` + where + `
+		ORDER BY b.start_time`
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []Booking
+	for rows.Next() {
+		var b Booking
+		if err := rows.Scan(&b.ID, &b.ResourceID, &b.UserID, &b.StartTime, &b.EndTime, &b.SeriesID,
+			&b.CheckedInAt, &b.CheckedOutAt); err != nil {
+			return nil, fmt.Errorf("failed to scan booking: %w", err)
+		}
+		bookings = append(bookings, b)
+	}
+
+	return bookings, rows.Err()
+}
+
+// GetBookingDetails получает записи, удовлетворяющие фильтру, вместе с данными ресурса и владельца
+func (r *Repository) GetBookingDetails(ctx context.Context, conn *pgx.Conn, filter BookingFilter) ([]BookingDetails, error) {
+	where, args := bookingConditions(filter)
+	query := `
+		SELECT b.id, b.resource_id, b.user_id, b.start_time, b.end_time, COALESCE(b.series_id, 0),
+			b.checked_in_at, b.checked_out_at, r.type, r.name, COALESCE(u.username, '')
+		FROM bookings b
+		JOIN resources r ON r.id = b.resource_id
+		LEFT JOIN users u ON u.id = b.user_id` + where + `
+		ORDER BY b.start_time`
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query booking details: %w", err)
+	}
+	defer rows.Close()
+
+	var bookings []BookingDetails
+	for rows.Next() {
+		var b BookingDetails
+		if err := rows.Scan(&b.ID, &b.ResourceID, &b.UserID, &b.StartTime, &b.EndTime, &b.SeriesID,
+			&b.CheckedInAt, &b.CheckedOutAt, &b.ResourceType, &b.ResourceName, &b.Username); err != nil {
+			return nil, fmt.Errorf("failed to scan booking details: %w", err)
+		}
+		bookings = append(bookings, b)
+	}
+
+	return bookings, rows.Err()
+}
+
+// bookingConditions строит WHERE для выборки записей (таблицы bookings b и resources r)
+func bookingConditions(filter BookingFilter) (string, []interface{}) {
+	var conditions []string
+	args := []interface{}{}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.ResourceID != 0 {
+		addCondition(`b.resource_id = $%d`, filter.ResourceID)
+	}
+	if filter.ResourceType != "" {
+		addCondition(`r.type = $%d`, filter.ResourceType)
+	}
+	if filter.UserID != 0 {
+		addCondition(`b.user_id = $%d`, filter.UserID)
+	}
+	if filter.StartTime != nil {
+		addCondition(`b.start_time >= $%d`, *filter.StartTime)
+	}
+	if filter.EndTime != nil {
+		addCondition(`b.end_time <= $%d`, *filter.EndTime)
+	}
+	if filter.StartBefore != nil {
+		addCondition(`b.start_time < $%d`, *filter.StartBefore)
+	}
+	if filter.EndAfter != nil {
+		addCondition(`b.end_time > $%d`, *filter.EndAfter)
+	}
+	if filter.SeriesID != 0 {
+		addCondition(`b.series_id = $%d`, filter.SeriesID)
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+
+	return ` WHERE ` + strings.Join(conditions, ` AND `), args
+}
+
+// GetBookingByID возвращает запись по ID
+func (r *Repository) GetBookingByID(ctx context.Context, conn *pgx.Conn, bookingID int) (Booking, error) {
+	var b Booking
+	err := conn.QueryRow(ctx, `
+		SELECT id, resource_id, user_id, start_time, end_time, COALESCE(series_id, 0), checked_in_at, checked_out_at
+		FROM bookings WHERE id = $1
+	`, bookingID).Scan(&b.ID, &b.ResourceID, &b.UserID, &b.StartTime, &b.EndTime, &b.SeriesID, &b.CheckedInAt, &b.CheckedOutAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Booking{}, domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+		}
+		return Booking{}, fmt.Errorf("failed to get booking %d: %w", bookingID, err)
+	}
+	return b, nil
+}
+
+// UpdateBookingTime переносит запись на другое время и/или другой ресурс.
+// Запись занимает первую свободную единицу ресурса, не считая самой себя
+func (r *Repository) UpdateBookingTime(ctx context.Context, conn *pgx.Conn, bookingID int, resource Resource, startTime, endTime time.Time) error {
+	var unit int
+	err := conn.QueryRow(ctx, `
+		SELECT u.unit
+		FROM generate_series(1, $2::int) AS u (unit)
+		WHERE NOT EXISTS (
+			SELECT 1 FROM bookings b
+			WHERE b.resource_id = $1 AND b.unit = u.unit AND b.id <> $5
+				AND b.period && tstzrange($3::timestamptz, $4::timestamptz, '[)')
+		)
+		ORDER BY u.unit
+		LIMIT 1
+	`, resource.ID, resource.Capacity, startTime, endTime, bookingID).Scan(&unit)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return ErrTimeSlotBooked
+		}
+		return fmt.Errorf("failed to find free unit for booking %d: %w", bookingID, err)
+	}
+
+	result, err := conn.Exec(ctx, `
+		UPDATE bookings
+		SET resource_id = $2, start_time = $3, end_time = $4, unit = $5
+		WHERE id = $1
+	`, bookingID, resource.ID, startTime, endTime, unit)
+	if err != nil {
+		if isExclusionViolation(err) {
+			return ErrTimeSlotBooked
+		}
+		return fmt.Errorf("failed to update booking %d: %w", bookingID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+	}
+
+	return nil
+}
+
+// DeleteBookingByID удаляет запись независимо от владельца (для администраторов).
+// Возвращает удаленную запись
+func (r *Repository) DeleteBookingByID(ctx context.Context, conn *pgx.Conn, bookingID int) (BookingDetails, error) {
+	var deleted BookingDetails
+	err := conn.QueryRow(ctx, `
+		DELETE FROM bookings b
+		USING resources r
+		WHERE r.id = b.resource_id AND b.id = $1
+		RETURNING b.id, b.resource_id, b.user_id, b.start_time, b.end_time, COALESCE(b.series_id, 0), r.type, r.name
+	`, bookingID).Scan(&deleted.ID, &deleted.ResourceID, &deleted.UserID,
+		&deleted.StartTime, &deleted.EndTime, &deleted.SeriesID, &deleted.ResourceType, &deleted.ResourceName)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return BookingDetails{}, domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+		}
+		return BookingDetails{}, fmt.Errorf("failed to delete booking %d: %w", bookingID, err)
+	}
+
+	return deleted, nil
+}
+
+// DeletePastBookings удаляет записи, закончившиеся раньше before.
+// Возвращает количество удаленных записей
+func (r *Repository) DeletePastBookings(ctx context.Context, conn *pgx.Conn, before time.Time) (int, error) {
+	result, err := conn.Exec(ctx, `
+		DELETE FROM bookings WHERE end_time < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete past bookings: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// DeleteBooking удаляет запись пользователя. Если resourceType не пустой,
+// удаляется только запись на ресурс этого типа. Возвращает удаленную запись с типом ресурса
+func (r *Repository) DeleteBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int, resourceType string) (BookingDetails, error) {
+	var deleted BookingDetails
+	err := conn.QueryRow(ctx, `
+		DELETE FROM bookings b
+		USING resources r
+		WHERE r.id = b.resource_id
+			AND b.id = $1 AND b.user_id = $2
+			AND ($3::text = '' OR r.type = $3)
+		RETURNING b.id, b.resource_id, b.user_id, b.start_time, b.end_time, COALESCE(b.series_id, 0), r.type, r.name
+	`, bookingID, userID, resourceType).Scan(&deleted.ID, &deleted.ResourceID, &deleted.UserID,
+		&deleted.StartTime, &deleted.EndTime, &deleted.SeriesID, &deleted.ResourceType, &deleted.ResourceName)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return BookingDetails{}, r.bookingAccessError(ctx, conn, bookingID, userID, resourceType)
+		}
+		return BookingDetails{}, fmt.Errorf("failed to delete booking: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// bookingAccessError выясняет, почему запись недоступна пользователю:
+// записи не существует (NotFound) или она принадлежит другому пользователю (Forbidden)
+func (r *Repository) bookingAccessError(ctx context.Context, conn *pgx.Conn, bookingID, userID int, resourceType string) error {
+	var ownerID int
+	err := conn.QueryRow(ctx, `
+		SELECT b.user_id FROM bookings b
+		JOIN resources r ON r.id = b.resource_id
+		WHERE b.id = $1 AND ($2::text = '' OR r.type = $2)
+	`, bookingID, resourceType).Scan(&ownerID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+		}
+		return fmt.Errorf("failed to check booking %d: %w", bookingID, err)
+	}
+
+	if ownerID != userID {
+		return domainErrors.Forbidden("NOT_BOOKING_OWNER", "user is not the owner of the booking")
+	}
+
+	return domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+}
+
+// GetActiveBan возвращает действующий запрет на бронирование для пользователя или nil
+func (r *Repository) GetActiveBan(ctx context.Context, conn *pgx.Conn, userID int) (*Ban, error) {
+	var ban Ban
+	var createdBy *int
+	err := conn.QueryRow(ctx, `
+		SELECT user_id, reason, banned_until, created_by
+		FROM booking_bans
+		WHERE user_id = $1 AND (banned_until IS NULL OR banned_until > NOW())
+	`, userID).Scan(&ban.UserID, &ban.Reason, &ban.BannedUntil, &createdBy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get booking ban for user %d: %w", userID, err)
+	}
+	if createdBy != nil {
+		ban.CreatedBy = *createdBy
+	}
+	return &ban, nil
+}
+
+// CreateBan запрещает пользователю бронирование (заменяет существующий запрет)
+func (r *Repository) CreateBan(ctx context.Context, conn *pgx.Conn, ban Ban) error {
+	result, err := conn.Exec(ctx, `
+		INSERT INTO booking_bans (user_id, reason, banned_until, created_by)
+		SELECT id, $2, $3, NULLIF($4, 0) FROM users WHERE id = $1
+		ON CONFLICT (user_id) DO UPDATE
+		SET reason = EXCLUDED.reason,
+			banned_until = EXCLUDED.banned_until,
+			created_by = EXCLUDED.created_by,
+			created_at = NOW()
+	`, ban.UserID, ban.Reason, ban.BannedUntil, ban.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to ban user %d: %w", ban.UserID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.NotFound("USER_NOT_FOUND", fmt.Sprintf("user %d not found", ban.UserID))
+	}
+	return nil
+}
+
+// DeleteBan снимает запрет на бронирование
+func (r *Repository) DeleteBan(ctx context.Context, conn *pgx.Conn, userID int) error {
+	result, err := conn.Exec(ctx, `
+		DELETE FROM booking_bans WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to unban user %d: %w", userID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.NotFound("BAN_NOT_FOUND", fmt.Sprintf("user %d is not banned", userID))
+	}
+	return nil
+}
+
+// Виды окон расписания помещения (facility_hours.kind)
+const (
+	HoursKindOpen  = "open"
+	HoursKindQuiet = "quiet"
+)
+
+// FacilityHours окно недельного расписания помещения по местному времени общежития.
+// Start и End - смещения от полуночи. Если End <= Start, окно переходит через полночь
+type FacilityHours struct {
+	ResourceType string
+	Kind         string
+	Weekday      time.Weekday
+	Start        time.Duration
+	End          time.Duration
+}
+
+// GetFacilityHours возвращает недельное расписание помещений типа resourceType
+func (r *Repository) GetFacilityHours(ctx context.Context, conn *pgx.Conn, resourceType string) ([]FacilityHours, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT resource_type, kind::text, weekday, start_time, end_time
+		FROM facility_hours
+		WHERE resource_type = $1
+		ORDER BY kind, weekday, start_time
+	`, resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query facility hours: %w", err)
+	}
+	defer rows.Close()
+
+	var hours []FacilityHours
+	for rows.Next() {
+		var h FacilityHours
+		var weekday int16
+		var start, end pgtype.Time
+		if err := rows.Scan(&h.ResourceType, &h.Kind, &weekday, &start, &end); err != nil {
+			return nil, fmt.Errorf("failed to scan facility hours: %w", err)
+		}
+		h.Weekday = time.Weekday(weekday)
+		h.Start = time.Duration(start.Microseconds) * time.Microsecond
+		h.End = time.Duration(end.Microseconds) * time.Microsecond
+		hours = append(hours, h)
+	}
+
+	return hours, rows.Err()
+}
+
+// Blackout период, когда ресурс закрыт администратором
+type Blackout struct {
+	ID         int
+	ResourceID int
+	StartTime  time.Time
+	EndTime    time.Time
+	Reason     string
+	CreatedBy  int
+}
+
+// BlackoutFilter условия выборки закрытий. Пустые поля не участвуют в фильтрации
+type BlackoutFilter struct {
+	ResourceID int
+	// StartTime и EndTime выбирают закрытия, пересекающие промежуток
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+const blackoutColumns = `id, resource_id, start_time, end_time, reason, created_by`
+
+func scanBlackout(row pgx.Row) (Blackout, error) {
+	var b Blackout
+	var createdBy *int
+	if err := row.Scan(&b.ID, &b.ResourceID, &b.StartTime, &b.EndTime, &b.Reason, &createdBy); err != nil {
+		return Blackout{}, err
+	}
+	if createdBy != nil {
+		b.CreatedBy = *createdBy
+	}
+	return b, nil
+}
+
+// CreateBlackout закрывает ресурс на период. Строка ресурса блокируется FOR UPDATE,
+// чтобы конкурентные записи (см. GetOverlappingBlackout) дождались фиксации закрытия
+func (r *Repository) CreateBlackout(ctx context.Context, conn *pgx.Conn, blackout Blackout) (int, error) {
+	if err := r.lockResource(ctx, conn, blackout.ResourceID, "UPDATE"); err != nil {
+		return 0, err
+	}
+
+	var blackoutID int
+	err := conn.QueryRow(ctx, `
+		INSERT INTO blackouts (resource_id, start_time, end_time, reason, created_by)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0))
+		RETURNING id
+	`, blackout.ResourceID, blackout.StartTime, blackout.EndTime, blackout.Reason, blackout.CreatedBy).Scan(&blackoutID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create blackout: %w", err)
+	}
+
+	return blackoutID, nil
+}
+
+// DeleteBlackout снимает закрытие ресурса
+func (r *Repository) DeleteBlackout(ctx context.Context, conn *pgx.Conn, blackoutID int) error {
+	result, err := conn.Exec(ctx, `
+		DELETE FROM blackouts WHERE id = $1
+	`, blackoutID)
+	if err != nil {
+		return fmt.Errorf("failed to delete blackout %d: %w", blackoutID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.NotFound("BLACKOUT_NOT_FOUND", fmt.Sprintf("blackout %d not found", blackoutID))
+	}
+	return nil
+}
+
+// GetBlackouts возвращает закрытия ресурсов, удовлетворяющие фильтру
+func (r *Repository) GetBlackouts(ctx context.Context, conn *pgx.Conn, filter BlackoutFilter) ([]Blackout, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+blackoutColumns+`
+		FROM blackouts
+		WHERE ($1::int = 0 OR resource_id = $1)
+			AND ($2::timestamptz IS NULL OR end_time > $2)
+			AND ($3::timestamptz IS NULL OR start_time < $3)
+		ORDER BY start_time
+	`, filter.ResourceID, filter.StartTime, filter.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blackouts: %w", err)
+	}
+	defer rows.Close()
+
+	var blackouts []Blackout
+	for rows.Next() {
+		b, err := scanBlackout(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan blackout: %w", err)
+		}
+		blackouts = append(blackouts, b)
+	}
+
+	return blackouts, rows.Err()
+}
+
+// GetOverlappingBlackout возвращает закрытие ресурса, пересекающее промежуток, или nil.
+// Строка ресурса блокируется FOR SHARE отдельным запросом: если закрытие создается
+// одновременно, проверка дождется его фиксации и увидит его
+func (r *Repository) GetOverlappingBlackout(ctx context.Context, conn *pgx.Conn, resourceID int, startTime, endTime time.Time) (*Blackout, error) {
+	if err := r.lockResource(ctx, conn, resourceID, "SHARE"); err != nil {
+		return nil, err
+	}
+
+	b, err := scanBlackout(conn.QueryRow(ctx, `
+		SELECT `+blackoutColumns+`
+		FROM blackouts
+		WHERE resource_id = $1 AND period && tstzrange($2::timestamptz, $3::timestamptz, '[)')
+		ORDER BY start_time
+		LIMIT 1
+	`, resourceID, startTime, endTime))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to check blackouts for resource %d: %w", resourceID, err)
+	}
+
+	return &b, nil
+}
+
+// IsOutOfOrder проверяет, что ресурс - неисправная машина прачечной. Строка машины
+// блокируется FOR SHARE, поэтому смена состояния дождется конца транзакции записи,
+// а запись - фиксации смены состояния
+func (r *Repository) IsOutOfOrder(ctx context.Context, conn *pgx.Conn, resourceID int) (bool, error) {
+	var status string
+	err := conn.QueryRow(ctx, `
+		SELECT status FROM laundry_machines WHERE id = $1 FOR SHARE
+	`, resourceID).Scan(&status)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check status of resource %d: %w", resourceID, err)
+	}
+	return status == "out_of_order", nil
+}
+
+// lockResource блокирует строку ресурса. mode - UPDATE или SHARE
+func (r *Repository) lockResource(ctx context.Context, conn *pgx.Conn, resourceID int, mode string) error {
+	var id int
+	err := conn.QueryRow(ctx, `
+		SELECT id FROM resources WHERE id = $1 FOR `+mode, resourceID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("resource %d not found", resourceID))
+		}
+		return fmt.Errorf("failed to lock resource %d: %w", resourceID, err)
+	}
+	return nil
+}
+
+// Cancellation отмена записи не владельцем. BlackoutID и CancelledBy равны 0, если не заданы
+type Cancellation struct {
+	Reason      string
+	BlackoutID  int
+	CancelledBy int
+}
+
+// CancelBookingsInRange удаляет записи на ресурс, пересекающие промежуток,
+// и сохраняет их в booking_cancellations с причиной отмены.
+// Возвращает количество отмененных записей
+func (r *Repository) CancelBookingsInRange(ctx context.Context, conn *pgx.Conn, resourceID int, startTime, endTime time.Time, cancellation Cancellation) (int, error) {
+	result, err := conn.Exec(ctx, `
+		WITH cancelled AS (
+			DELETE FROM bookings
+			WHERE resource_id = $1 AND period && tstzrange($2::timestamptz, $3::timestamptz, '[)')
+			RETURNING id, resource_id, user_id, start_time, end_time
+		)
+		INSERT INTO booking_cancellations (booking_id, resource_id, user_id, start_time, end_time, reason, blackout_id, cancelled_by)
+		SELECT id, resource_id, user_id, start_time, end_time, $4, NULLIF($5, 0), NULLIF($6, 0)
+		FROM cancelled
+	`, resourceID, startTime, endTime, cancellation.Reason, cancellation.BlackoutID, cancellation.CancelledBy)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel bookings for resource %d: %w", resourceID, err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// Quota лимиты бронирования для одного пользователя по типу ресурса. 0 - без ограничения
+type Quota struct {
+	ResourceType      string
+	MaxActiveBookings int
+	MaxMinutesPerDay  int
+	MaxMinutesPerWeek int
+	MinGapMinutes     int
+	MaxAdvanceMinutes int
+}
+
+// QuotaUsage использование лимитов пользователем
+type QuotaUsage struct {
+	// ActiveBookings записи, которые еще не закончились
+	ActiveBookings int
+	DayMinutes     int
+	WeekMinutes    int
+}
+
+// GetQuota возвращает лимиты для типа ресурса. Если лимиты не заданы, все поля равны 0
+func (r *Repository) GetQuota(ctx context.Context, conn *pgx.Conn, resourceType string) (Quota, error) {
+	quota := Quota{ResourceType: resourceType}
+	err := conn.QueryRow(ctx, `
+		SELECT max_active_bookings, max_minutes_per_day, max_minutes_per_week, min_gap_minutes, max_advance_minutes
+		FROM booking_quotas WHERE resource_type = $1
+	`, resourceType).Scan(&quota.MaxActiveBookings, &quota.MaxMinutesPerDay, &quota.MaxMinutesPerWeek,
+		&quota.MinGapMinutes, &quota.MaxAdvanceMinutes)
+	if err != nil && err != pgx.ErrNoRows {
+		return Quota{}, fmt.Errorf("failed to get booking quota for %s: %w", resourceType, err)
+	}
+	return quota, nil
+}
+
+// LockUser блокирует строку пользователя до конца транзакции, чтобы проверки лимитов
+// для одного пользователя выполнялись последовательно
+func (r *Repository) LockUser(ctx context.Context, conn *pgx.Conn, userID int) error {
+	var id int
+	err := conn.QueryRow(ctx, `
+		SELECT id FROM users WHERE id = $1 FOR NO KEY UPDATE
+	`, userID).Scan(&id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domainErrors.NotFound("USER_NOT_FOUND", fmt.Sprintf("user %d not found", userID))
+		}
+		return fmt.Errorf("failed to lock user %d: %w", userID, err)
+	}
+	return nil
+}
+
+// GetQuotaUsage считает записи пользователя на ресурсы типа resourceType:
+// незакончившиеся к моменту now и суммарную длительность записей,
+// начинающихся в промежутках [dayStart, dayEnd) и [weekStart, weekEnd).
+// Запись excludeBookingID (изменяемая запись) не учитывается
+func (r *Repository) GetQuotaUsage(ctx context.Context, conn *pgx.Conn, userID int, resourceType string, now, dayStart, dayEnd, weekStart, weekEnd time.Time, excludeBookingID int) (QuotaUsage, error) {
+	var usage QuotaUsage
+	err := conn.QueryRow(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE b.end_time > $3),
+			COALESCE(SUM(EXTRACT(EPOCH FROM b.end_time - b.start_time) / 60)
+				FILTER (WHERE b.start_time >= $4 AND b.start_time < $5), 0)::int,
+			COALESCE(SUM(EXTRACT(EPOCH FROM b.end_time - b.start_time) / 60)
+				FILTER (WHERE b.start_time >= $6 AND b.start_time < $7), 0)::int
+		FROM bookings b
+		JOIN resources r ON r.id = b.resource_id
+		WHERE b.user_id = $1 AND r.type = $2 AND b.id <> $8
+	`, userID, resourceType, now, dayStart, dayEnd, weekStart, weekEnd, excludeBookingID).Scan(&usage.ActiveBookings, &usage.DayMinutes, &usage.WeekMinutes)
+	if err != nil {
+		return QuotaUsage{}, fmt.Errorf("failed to get quota usage for user %d: %w", userID, err)
+	}
+	return usage, nil
+}
+
+// HasUserBookingBetween проверяет, есть ли у пользователя запись на ресурс типа resourceType,
+// кроме excludeBookingID, пересекающая промежуток [startTime, endTime)
+func (r *Repository) HasUserBookingBetween(ctx context.Context, conn *pgx.Conn, userID int, resourceType string, startTime, endTime time.Time, excludeBookingID int) (bool, error) {
+	var exists bool
+	err := conn.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM bookings b
+			JOIN resources r ON r.id = b.resource_id
+			WHERE b.user_id = $1 AND r.type = $2 AND b.id <> $5
+				AND b.period && tstzrange($3::timestamptz, $4::timestamptz, '[)')
+		)
+	`, userID, resourceType, startTime, endTime, excludeBookingID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check bookings of user %d: %w", userID, err)
+	}
+	return exists, nil
+}
+
+// Series серия повторяющихся записей. Повторение i начинается через i*IntervalWeeks недель
+// после StartTime в то же время по часам общежития. Count = 0 - без ограничения
+// количества, Until = nil - без ограничения по времени (хотя бы одно ограничение задано)
+type Series struct {
+	ID            int
+	UserID        int
+	ResourceID    int
+	StartTime     time.Time
+	EndTime       time.Time
+	IntervalWeeks int
+	Count         int
+	// Until повторения, начинающиеся в этот момент или позже, не входят в серию
+	Until     *time.Time
+	CreatedAt time.Time
+}
+
+const seriesColumns = `id, user_id, resource_id, first_start, first_end, interval_weeks,
+	COALESCE(occurrence_count, 0), until, created_at`
+
+func scanSeries(row pgx.Row) (Series, error) {
+	var s Series
+	err := row.Scan(&s.ID, &s.UserID, &s.ResourceID, &s.StartTime, &s.EndTime, &s.IntervalWeeks,
+		&s.Count, &s.Until, &s.CreatedAt)
+	return s, err
+}
+
+// CreateSeries создает серию повторяющихся записей. Сами записи создаются отдельно
+// и привязываются к серии через AttachBookingsToSeries
+func (r *Repository) CreateSeries(ctx context.Context, conn *pgx.Conn, series Series) (int, error) {
+	var seriesID int
+	err := conn.QueryRow(ctx, `
+		INSERT INTO booking_series (user_id, resource_id, first_start, first_end, interval_weeks, occurrence_count, until)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0), $7)
+		RETURNING id
+	`, series.UserID, series.ResourceID, series.StartTime, series.EndTime, series.IntervalWeeks,
+		series.Count, series.Until).Scan(&seriesID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create booking series: %w", err)
+	}
+	return seriesID, nil
+}
+
+// AttachBookingsToSeries привязывает записи к серии
+func (r *Repository) AttachBookingsToSeries(ctx context.Context, conn *pgx.Conn, seriesID int, bookingIDs []int) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE bookings SET series_id = $1 WHERE id = ANY($2)
+	`, seriesID, bookingIDs)
+	if err != nil {
+		return fmt.Errorf("failed to attach bookings to series %d: %w", seriesID, err)
+	}
+	return nil
+}
+
+// GetSeriesForUpdate возвращает серию пользователя и блокирует ее до конца транзакции
+func (r *Repository) GetSeriesForUpdate(ctx context.Context, conn *pgx.Conn, seriesID, userID int) (Series, error) {
+	series, err := scanSeries(conn.QueryRow(ctx, `
+		SELECT `+seriesColumns+`
+		FROM booking_series
+		WHERE id = $1
+		FOR UPDATE
+	`, seriesID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Series{}, domainErrors.NotFound("SERIES_NOT_FOUND", fmt.Sprintf("booking series %d not found", seriesID))
+		}
+		return Series{}, fmt.Errorf("failed to get booking series %d: %w", seriesID, err)
+	}
+	if series.UserID != userID {
+		return Series{}, domainErrors.Forbidden("NOT_SERIES_OWNER", "user is not the owner of the booking series")
+	}
+	return series, nil
+}
+
+// GetUserSeries возвращает серии пользователя, начиная с новых
+func (r *Repository) GetUserSeries(ctx context.Context, conn *pgx.Conn, userID int, limit int) ([]Series, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+seriesColumns+`
+		FROM booking_series
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query booking series: %w", err)
+	}
+	defer rows.Close()
+
+	var series []Series
+	for rows.Next() {
+		s, err := scanSeries(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan booking series: %w", err)
+		}
+		series = append(series, s)
+	}
+
+	return series, rows.Err()
+}
+
+// TruncateSeries ограничивает серию повторениями, начинающимися раньше until
+func (r *Repository) TruncateSeries(ctx context.Context, conn *pgx.Conn, seriesID int, until time.Time) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE booking_series
+		SET until = LEAST(COALESCE(until, $2), $2)
+		WHERE id = $1
+	`, seriesID, until)
+	if err != nil {
+		return fmt.Errorf("failed to truncate booking series %d: %w", seriesID, err)
+	}
+	return nil
+}
+
+// DeleteSeriesBookings удаляет записи серии, начинающиеся не раньше from.
+// Возвращает удаленные записи с типом ресурса
+func (r *Repository) DeleteSeriesBookings(ctx context.Context, conn *pgx.Conn, seriesID int, from time.Time) ([]BookingDetails, error) {
+	rows, err := conn.Query(ctx, `
+		DELETE FROM bookings b
+		USING resources r
+		WHERE r.id = b.resource_id AND b.series_id = $1 AND b.start_time >= $2
+		RETURNING b.id, b.resource_id, b.user_id, b.start_time, b.end_time, b.series_id, r.type, r.name
+	`, seriesID, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete bookings of series %d: %w", seriesID, err)
+	}
+	defer rows.Close()
+
+	var deleted []BookingDetails
+	for rows.Next() {
+		var b BookingDetails
+		if err := rows.Scan(&b.ID, &b.ResourceID, &b.UserID, &b.StartTime, &b.EndTime, &b.SeriesID,
+			&b.ResourceType, &b.ResourceName); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted booking: %w", err)
+		}
+		deleted = append(deleted, b)
+	}
+
+	return deleted, rows.Err()
+}
+
+// ReasonNoShow причина освобождения записи, на которую пользователь не пришел
+const ReasonNoShow = "NO_SHOW"
+
+// GetResourceByCheckInToken возвращает ресурс по токену из его QR-кода
+func (r *Repository) GetResourceByCheckInToken(ctx context.Context, conn *pgx.Conn, token string) (Resource, error) {
+	res, err := scanResource(conn.QueryRow(ctx, `
+		SELECT `+resourceColumns+` FROM resources WHERE check_in_token = $1
+	`, token))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Resource{}, domainErrors.NotFound("INVALID_CHECK_IN_TOKEN", "check-in code is not valid")
+		}
+		return Resource{}, fmt.Errorf("failed to get resource by check-in token: %w", err)
+	}
+	return res, nil
+}
+
+// SetCheckInToken задает токен QR-кода ресурса. Прежний токен перестает действовать
+func (r *Repository) SetCheckInToken(ctx context.Context, conn *pgx.Conn, resourceID int, token string) error {
+	result, err := conn.Exec(ctx, `
+		UPDATE resources SET check_in_token = $2 WHERE id = $1
+	`, resourceID, token)
+	if err != nil {
+		return fmt.Errorf("failed to set check-in token for resource %d: %w", resourceID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("resource %d not found", resourceID))
+	}
+	return nil
+}
+
+// CheckIn отмечает приход на запись
+func (r *Repository) CheckIn(ctx context.Context, conn *pgx.Conn, bookingID int, at time.Time) error {
+	result, err := conn.Exec(ctx, `
+		UPDATE bookings SET checked_in_at = $2 WHERE id = $1 AND checked_in_at IS NULL
+	`, bookingID, at)
+	if err != nil {
+		return fmt.Errorf("failed to check in booking %d: %w", bookingID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.Conflict("ALREADY_CHECKED_IN", "booking is already checked in")
+	}
+	return nil
+}
+
+// CheckOut отмечает уход. Если запись еще идет, она заканчивается в момент at
+func (r *Repository) CheckOut(ctx context.Context, conn *pgx.Conn, bookingID int, at time.Time) error {
+	result, err := conn.Exec(ctx, `
+		UPDATE bookings
+		SET checked_out_at = $2,
+			end_time = CASE WHEN $2 > start_time AND $2 < end_time THEN $2 ELSE end_time END
+		WHERE id = $1 AND checked_in_at IS NOT NULL AND checked_out_at IS NULL
+	`, bookingID, at)
+	if err != nil {
+		return fmt.Errorf("failed to check out booking %d: %w", bookingID, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.Conflict("ALREADY_CHECKED_OUT", "booking is already checked out")
+	}
+	return nil
+}
+
+// ReleaseNoShows удаляет записи на ресурсы с обязательной отметкой, на которые не отметились
+// за check_in_grace_minutes после начала (или после создания записи, если она создана позже),
+// переносит их в архив отмен и записывает неявки. Возвращает освобожденные записи
+func (r *Repository) ReleaseNoShows(ctx context.Context, conn *pgx.Conn, now time.Time) ([]BookingDetails, error) {
+	rows, err := conn.Query(ctx, `
+		WITH released AS (
+			DELETE FROM bookings b
+			USING resources r
+			WHERE r.id = b.resource_id
+				AND b.checked_in_at IS NULL
+				AND COALESCE((r.rules->>'check_in_grace_minutes')::int, 0) > 0
+				AND GREATEST(b.start_time, b.created_at)
+					+ (r.rules->>'check_in_grace_minutes')::int * interval '1 minute' <= $1
+			RETURNING b.id, b.resource_id, b.user_id, b.start_time, b.end_time,
+				COALESCE(b.series_id, 0) AS series_id, r.type AS resource_type, r.name AS resource_name
+		), archived AS (
+			INSERT INTO booking_cancellations (booking_id, resource_id, user_id, start_time, end_time, reason)
+			SELECT id, resource_id, user_id, start_time, end_time, $2 FROM released
+		), no_shows AS (
+			INSERT INTO booking_no_shows (user_id, booking_id, resource_id, start_time, end_time)
+			SELECT user_id, id, resource_id, start_time, end_time FROM released WHERE user_id IS NOT NULL
+		)
+		SELECT id, resource_id, COALESCE(user_id, 0), start_time, end_time, series_id, resource_type, resource_name
+		FROM released
+	`, now, ReasonNoShow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release no-show bookings: %w", err)
+	}
+	defer rows.Close()
+
+	var released []BookingDetails
+	for rows.Next() {
+		var b BookingDetails
+		if err := rows.Scan(&b.ID, &b.ResourceID, &b.UserID, &b.StartTime, &b.EndTime, &b.SeriesID,
+			&b.ResourceType, &b.ResourceName); err != nil {
+			return nil, fmt.Errorf("failed to scan released booking: %w", err)
+		}
+		released = append(released, b)
+	}
+
+	return released, rows.Err()
+}
+
+// CountNoShows возвращает количество неявок пользователя, записанных начиная с since
+func (r *Repository) CountNoShows(ctx context.Context, conn *pgx.Conn, userID int, since time.Time) (int, error) {
+	var count int
+	err := conn.QueryRow(ctx, `
+		SELECT COUNT(*) FROM booking_no_shows WHERE user_id = $1 AND created_at >= $2
+	`, userID, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count no-shows of user %d: %w", userID, err)
+	}
+	return count, nil
+}
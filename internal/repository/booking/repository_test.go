@@ -0,0 +1,194 @@
+package bookingRepository_test
+
+import (
+	"context"
+	"dormitory-helper-service/internal/migrator"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// testDatabaseEnv переменная окружения с DSN тестовой базы. Без нее тесты
+// с настоящим Postgres пропускаются. Миграции применяются к этой базе
+const testDatabaseEnv = "TEST_DATABASE_URL"
+
+// concurrentBookings сколько записей создается одновременно
+const concurrentBookings = 30
+
+// openTestDB подключается к тестовой базе и применяет миграции
+func openTestDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", testDatabaseEnv, err)
+	}
+	// Каждой горутине свое соединение, чтобы вставки действительно шли одновременно
+	config.MaxConns = concurrentBookings + 2
+
+	ctx := context.Background()
+	db, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	sqlDB := stdlib.OpenDBFromPool(db)
+	defer sqlDB.Close()
+
+	m, err := migrator.New(sqlDB)
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	return db
+}
+
+// createTestResource создает ресурс с заданной вместимостью и удаляет его после теста
+func createTestResource(t *testing.T, db *pgxpool.Pool, capacity int) bookingRepository.Resource {
+	t.Helper()
+
+	ctx := context.Background()
+	var id int
+	err := db.QueryRow(ctx, `
+		INSERT INTO resources (type, name, max_duration_minutes, capacity)
+		VALUES ('test', $1, 120, $2)
+		RETURNING id
+	`, fmt.Sprintf("test resource %d", time.Now().UnixNano()), capacity).Scan(&id)
+	if err != nil {
+		t.Fatalf("failed to create resource: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = db.Exec(context.Background(), `DELETE FROM resources WHERE id = $1`, id)
+	})
+
+	conn, err := db.Acquire(ctx)
+	if err != nil {
+		t.Fatalf("failed to acquire connection: %v", err)
+	}
+	defer conn.Release()
+
+	resource, err := bookingRepository.NewRepository().GetResourceByID(ctx, conn.Conn(), id)
+	if err != nil {
+		t.Fatalf("failed to get resource: %v", err)
+	}
+	return resource
+}
+
+// createTestUsers создает n пользователей и удаляет их после теста
+func createTestUsers(t *testing.T, db *pgxpool.Pool, n int) []int {
+	t.Helper()
+
+	prefix := fmt.Sprintf("test_%d_", time.Now().UnixNano())
+	rows, err := db.Query(context.Background(), `
+		INSERT INTO users (username)
+		SELECT $1 || i FROM generate_series(1, $2::int) AS i
+		RETURNING id
+	`, prefix, n)
+	if err != nil {
+		t.Fatalf("failed to create users: %v", err)
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("failed to scan user: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("failed to create users: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = db.Exec(context.Background(), `DELETE FROM users WHERE username LIKE $1 || '%'`, prefix)
+	})
+	return ids
+}
+
+// TestCreateBookingConcurrent одновременно записывает много пользователей на пересекающееся
+// время: успешных записей должно быть ровно столько, сколько единиц у ресурса,
+// остальные получают ErrTimeSlotBooked
+func TestCreateBookingConcurrent(t *testing.T) {
+	db := openTestDB(t)
+
+	tests := []struct {
+		name     string
+		capacity int
+	}{
+		{name: "single unit", capacity: 1},
+		{name: "several units", capacity: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := createTestResource(t, db, tt.capacity)
+			users := createTestUsers(t, db, concurrentBookings)
+			repo := bookingRepository.NewRepository()
+
+			// Все промежутки содержат [base+29m, base+60m), поэтому пересекаются попарно
+			base := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+
+			var wg sync.WaitGroup
+			start := make(chan struct{})
+			errs := make([]error, concurrentBookings)
+			for i := 0; i < concurrentBookings; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					<-start
+					startTime := base.Add(time.Duration(i) * time.Minute)
+					errs[i] = createInTx(context.Background(), db, repo, resource, users[i], startTime, startTime.Add(time.Hour))
+				}(i)
+			}
+			close(start)
+			wg.Wait()
+
+			created := 0
+			for i, err := range errs {
+				switch {
+				case err == nil:
+					created++
+				case errors.Is(err, bookingRepository.ErrTimeSlotBooked):
+				default:
+					t.Errorf("goroutine %d: unexpected error: %v", i, err)
+				}
+			}
+			if created != tt.capacity {
+				t.Errorf("created %d bookings, want %d", created, tt.capacity)
+			}
+		})
+	}
+}
+
+// createInTx создает запись в отдельной транзакции, как это делает сервис
+func createInTx(ctx context.Context, db *pgxpool.Pool, repo *bookingRepository.Repository, resource bookingRepository.Resource, userID int, startTime, endTime time.Time) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := repo.CreateBooking(ctx, tx.Conn(), resource, userID, startTime, endTime); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
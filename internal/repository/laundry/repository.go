@@ -2,235 +2,78 @@ package laundryRepository
 
 import (
 	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
 	"fmt"
-	"time"
 
 	"github.com/jackc/pgx/v5"
 )
 
+// Типы машин в прачечной
+const (
+	MachineTypeWasher = "washer"
+	MachineTypeDryer  = "dryer"
+)
+
+// Состояния машин в прачечной
+const (
+	MachineStatusAvailable  = "available"
+	MachineStatusOutOfOrder = "out_of_order"
+)
+
 type Repository struct{}
 
 func NewRepository() *Repository {
 	return &Repository{}
 }
 
-type Booking struct {
-	ID        int
-	UserID    int
-	StartTime time.Time
-	EndTime   time.Time
-}
-
-// CreateLaundryBooking создает запись на стирку
-func (r *Repository) CreateLaundryBooking(ctx context.Context, conn *pgx.Conn, userID int, startTime, endTime time.Time) (int, error) {
-	// Проверка на пересечение с существующими записями
-	var count int
-	err := conn.QueryRow(ctx, `
-		SELECT COUNT(*) FROM laundry_bookings
-		WHERE (start_time, end_time) OVERLAPS ($1, $2)
-	`, startTime, endTime).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("failed to check booking overlap: %w", err)
-	}
-	if count > 0 {
-		return 0, fmt.Errorf("time slot is already booked")
-	}
-
-	// Создание записи
-	var bookingID int
-	err = conn.QueryRow(ctx, `
-		INSERT INTO laundry_bookings (user_id, start_time, end_time)
-		VALUES ($1, $2, $3)
-		RETURNING id
-	`, userID, startTime, endTime).Scan(&bookingID)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create laundry booking: %w", err)
-	}
-
-	return bookingID, nil
-}
-
-// GetLaundryBookings получает все записи на стирку в заданном диапазоне времени
-func (r *Repository) GetLaundryBookings(ctx context.Context, conn *pgx.Conn, startTime, endTime *time.Time) ([]Booking, error) {
-	query := `SELECT id, user_id, start_time, end_time FROM laundry_bookings`
-	args := []interface{}{}
-
-	if startTime != nil && endTime != nil {
-		query += ` WHERE start_time >= $1 AND end_time <= $2`
-		args = append(args, *startTime, *endTime)
-	} else if startTime != nil {
-		query += ` WHERE start_time >= $1`
-		args = append(args, *startTime)
-	} else if endTime != nil {
-		query += ` WHERE end_time <= $1`
-		args = append(args, *endTime)
-	}
-
-	query += ` ORDER BY start_time`
-
-	rows, err := conn.Query(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query laundry bookings: %w", err)
-	}
-	defer rows.Close()
-
-	var bookings []Booking
-	for rows.Next() {
-		var b Booking
-		if err := rows.Scan(&b.ID, &b.UserID, &b.StartTime, &b.EndTime); err != nil {
-			return nil, fmt.Errorf("failed to scan laundry booking: %w", err)
-		}
-		bookings = append(bookings, b)
-	}
-
-	return bookings, nil
+type Machine struct {
+	ID     int
+	Name   string
+	Type   string
+	Floor  int
+	Status string
 }
 
-// GetUserLaundryBookings получает все записи пользователя на стирку
-func (r *Repository) GetUserLaundryBookings(ctx context.Context, conn *pgx.Conn, userID int) ([]Booking, error) {
+// GetMachines возвращает машины прачечной. Если machineType пустой - машины всех типов
+func (r *Repository) GetMachines(ctx context.Context, conn *pgx.Conn, machineType string) ([]Machine, error) {
 	rows, err := conn.Query(ctx, `
-		SELECT id, user_id, start_time, end_time 
-		FROM laundry_bookings
-		WHERE user_id = $1
-		ORDER BY start_time
-	`, userID)
+		SELECT m.id, r.name, m.type, m.floor, m.status
+		FROM laundry_machines m
+		JOIN resources r ON r.id = m.id
+		WHERE r.is_active AND ($1::text = '' OR m.type::text = $1)
+		ORDER BY m.floor, m.id
+	`, machineType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query user laundry bookings: %w", err)
+		return nil, fmt.Errorf("failed to query laundry machines: %w", err)
 	}
 	defer rows.Close()
 
-	var bookings []Booking
+	var machines []Machine
 	for rows.Next() {
-		var b Booking
-		if err := rows.Scan(&b.ID, &b.UserID, &b.StartTime, &b.EndTime); err != nil {
-			return nil, fmt.Errorf("failed to scan user laundry booking: %w", err)
+		var m Machine
+		if err := rows.Scan(&m.ID, &m.Name, &m.Type, &m.Floor, &m.Status); err != nil {
+			return nil, fmt.Errorf("failed to scan laundry machine: %w", err)
 		}
-		bookings = append(bookings, b)
+		machines = append(machines, m)
 	}
 
-	return bookings, nil
+	return machines, rows.Err()
 }
 
-// DeleteLaundryBooking удаляет запись на стирку
-func (r *Repository) DeleteLaundryBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int) error {
-	result, err := conn.Exec(ctx, `
-		DELETE FROM laundry_bookings
-		WHERE id = $1 AND user_id = $2
-	`, bookingID, userID)
-	if err != nil {
-		return fmt.Errorf("failed to delete laundry booking: %w", err)
-	}
-
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("booking not found or user is not the owner")
-	}
-
-	return nil
-}
-
-// CreateKitchenBooking создает запись на кухню
-func (r *Repository) CreateKitchenBooking(ctx context.Context, conn *pgx.Conn, userID int, startTime, endTime time.Time) (int, error) {
-	// Проверка на пересечение с существующими записями
-	var count int
+// GetMachineByID возвращает машину прачечной по ID
+func (r *Repository) GetMachineByID(ctx context.Context, conn *pgx.Conn, machineID int) (Machine, error) {
+	var m Machine
 	err := conn.QueryRow(ctx, `
-		SELECT COUNT(*) FROM kitchen_bookings
-		WHERE (start_time, end_time) OVERLAPS ($1, $2)
-	`, startTime, endTime).Scan(&count)
+		SELECT m.id, r.name, m.type, m.floor, m.status
+		FROM laundry_machines m
+		JOIN resources r ON r.id = m.id
+		WHERE m.id = $1
+	`, machineID).Scan(&m.ID, &m.Name, &m.Type, &m.Floor, &m.Status)
 	if err != nil {
-		return 0, fmt.Errorf("failed to check booking overlap: %w", err)
-	}
-	if count > 0 {
-		return 0, fmt.Errorf("time slot is already booked")
-	}
-
-	// Создание записи
-	var bookingID int
-	err = conn.QueryRow(ctx, `
-		INSERT INTO kitchen_bookings (user_id, start_time, end_time)
-		VALUES ($1, $2, $3)
-		RETURNING id
-	`, userID, startTime, endTime).Scan(&bookingID)
-	if err != nil {
-		return 0, fmt.Errorf("failed to create kitchen booking: %w", err)
-	}
-
-	return bookingID, nil
-}
-
-// GetKitchenBookings получает все записи на кухню в заданном диапазоне времени
-func (r *Repository) GetKitchenBookings(ctx context.Context, conn *pgx.Conn, startTime, endTime *time.Time) ([]Booking, error) {
-	query := `SELECT id, user_id, start_time, end_time FROM kitchen_bookings`
-	args := []interface{}{}
-
-	if startTime != nil && endTime != nil {
-		query += ` WHERE start_time >= $1 AND end_time <= $2`
-		args = append(args, *startTime, *endTime)
-	} else if startTime != nil {
-		query += ` WHERE start_time >= $1`
-		args = append(args, *startTime)
-	} else if endTime != nil {
-		query += ` WHERE end_time <= $1`
-		args = append(args, *endTime)
-	}
-
-	query += ` ORDER BY start_time`
-
-	rows, err := conn.Query(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query kitchen bookings: %w", err)
-	}
-	defer rows.Close()
-
-	var bookings []Booking
-	for rows.Next() {
-		var b Booking
-		if err := rows.Scan(&b.ID, &b.UserID, &b.StartTime, &b.EndTime); err != nil {
-			return nil, fmt.Errorf("failed to scan kitchen booking: %w", err)
+		if err == pgx.ErrNoRows {
+			return Machine{}, domainErrors.NotFound("MACHINE_NOT_FOUND", fmt.Sprintf("laundry machine %d not found", machineID))
 		}
-		bookings = append(bookings, b)
+		return Machine{}, fmt.Errorf("failed to get laundry machine %d: %w", machineID, err)
 	}
-
-	return bookings, nil
-}
-
-// GetUserKitchenBookings получает все записи пользователя на кухню
-func (r *Repository) GetUserKitchenBookings(ctx context.Context, conn *pgx.Conn, userID int) ([]Booking, error) {
-	rows, err := conn.Query(ctx, `
-		SELECT id, user_id, start_time, end_time 
-		FROM kitchen_bookings
-		WHERE user_id = $1
-		ORDER BY start_time
-	`, userID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query user kitchen bookings: %w", err)
-	}
-	defer rows.Close()
-
-	var bookings []Booking
-	for rows.Next() {
-		var b Booking
-		if err := rows.Scan(&b.ID, &b.UserID, &b.StartTime, &b.EndTime); err != nil {
-			return nil, fmt.Errorf("failed to scan user kitchen booking: %w", err)
-		}
-		bookings = append(bookings, b)
-	}
-
-	return bookings, nil
-}
-
-// DeleteKitchenBooking удаляет запись на кухню
-func (r *Repository) DeleteKitchenBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int) error {
-	result, err := conn.Exec(ctx, `
-		DELETE FROM kitchen_bookings
-		WHERE id = $1 AND user_id = $2
-	`, bookingID, userID)
-	if err != nil {
-		return fmt.Errorf("failed to delete kitchen booking: %w", err)
-	}
-
-	if result.RowsAffected() == 0 {
-		return fmt.Errorf("booking not found or user is not the owner")
-	}
-
-	return nil
+	return m, nil
 }
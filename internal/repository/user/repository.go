@@ -2,6 +2,8 @@ package userRepository
 
 import (
 	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	jwtUtils "dormitory-helper-service/internal/utils/jwt"
 	"fmt"
 	"time"
 
@@ -102,14 +104,41 @@ func (r *Repository) CheckUserExpired(ctx context.Context, conn *pgx.Conn, userI
 func (r *Repository) HasActiveBookings(ctx context.Context, conn *pgx.Conn, userId int) (bool, error) {
 	var count int
 	err := conn.QueryRow(ctx, `
-		SELECT COUNT(*) FROM (
-			SELECT 1 FROM public.laundry_bookings WHERE user_id = $1
-			UNION ALL
-			SELECT 1 FROM public.kitchen_bookings WHERE user_id = $1
-		) as bookings
+		SELECT COUNT(*) FROM public.bookings WHERE user_id = $1
 	`, userId).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("failed to check bookings for user %d: %w", userId, err)
 	}
 	return count > 0, nil
 }
+
+// GetUserRole возвращает роль пользователя. Если роль не назначена - "user"
+func (r *Repository) GetUserRole(ctx context.Context, conn *pgx.Conn, userId int) (string, error) {
+	var role string
+	err := conn.QueryRow(ctx, `
+		SELECT role FROM public.roles WHERE id = $1
+	`, userId).Scan(&role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return jwtUtils.RoleUser, nil
+		}
+		return "", fmt.Errorf("failed to get role for user %d: %w", userId, err)
+	}
+	return role, nil
+}
+
+// SetUserRole назначает роль пользователю
+func (r *Repository) SetUserRole(ctx context.Context, tx *pgx.Conn, userId int, role string) error {
+	result, err := tx.Exec(ctx, `
+		INSERT INTO public.roles (id, role)
+		SELECT id, $2::user_role FROM public.users WHERE id = $1
+		ON CONFLICT (id) DO UPDATE SET role = EXCLUDED.role
+	`, userId, role)
+	if err != nil {
+		return fmt.Errorf("failed to set role for user %d: %w", userId, err)
+	}
+	if result.RowsAffected() == 0 {
+		return domainErrors.NotFound("USER_NOT_FOUND", fmt.Sprintf("user %d not found", userId))
+	}
+	return nil
+}
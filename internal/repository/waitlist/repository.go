@@ -0,0 +1,229 @@
+package waitlistRepository
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Состояния заявки в листе ожидания
+const (
+	StatusWaiting   = "waiting"
+	StatusHeld      = "held"
+	StatusBooked    = "booked"
+	StatusExpired   = "expired"
+	StatusCancelled = "cancelled"
+)
+
+type Repository struct{}
+
+func NewRepository() *Repository {
+	return &Repository{}
+}
+
+// Entry заявка в листе ожидания. ResourceID = 0 - любой ресурс типа ResourceType
+type Entry struct {
+	ID              int
+	UserID          int
+	ResourceType    string
+	ResourceID      int
+	WindowStart     time.Time
+	WindowEnd       time.Time
+	DurationMinutes int
+	AutoBook        bool
+	Status          string
+	BookingID       int
+	HoldUntil       *time.Time
+	CreatedAt       time.Time
+}
+
+const entryColumns = `id, user_id, resource_type, resource_id, window_start, window_end,
+	duration_minutes, auto_book, status::text, booking_id, hold_until, created_at`
+
+func scanEntry(row pgx.Row) (Entry, error) {
+	var e Entry
+	var resourceID, bookingID *int
+	err := row.Scan(&e.ID, &e.UserID, &e.ResourceType, &resourceID, &e.WindowStart, &e.WindowEnd,
+		&e.DurationMinutes, &e.AutoBook, &e.Status, &bookingID, &e.HoldUntil, &e.CreatedAt)
+	if err != nil {
+		return Entry{}, err
+	}
+	if resourceID != nil {
+		e.ResourceID = *resourceID
+	}
+	if bookingID != nil {
+		e.BookingID = *bookingID
+	}
+	return e, nil
+}
+
+func collectEntries(rows pgx.Rows) ([]Entry, error) {
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		e, err := scanEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan waitlist entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// CreateEntry добавляет заявку в лист ожидания
+func (r *Repository) CreateEntry(ctx context.Context, conn *pgx.Conn, entry Entry) (int, error) {
+	var entryID int
+	err := conn.QueryRow(ctx, `
+		INSERT INTO waitlist_entries (user_id, resource_type, resource_id, window_start, window_end, duration_minutes, auto_book)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5, $6, $7)
+		RETURNING id
+	`, entry.UserID, entry.ResourceType, entry.ResourceID, entry.WindowStart, entry.WindowEnd,
+		entry.DurationMinutes, entry.AutoBook).Scan(&entryID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+	return entryID, nil
+}
+
+// CountActiveEntries возвращает количество ожидающих и удерживаемых заявок пользователя
+func (r *Repository) CountActiveEntries(ctx context.Context, conn *pgx.Conn, userID int) (int, error) {
+	var count int
+	err := conn.QueryRow(ctx, `
+		SELECT COUNT(*) FROM waitlist_entries
+		WHERE user_id = $1 AND status IN ('waiting', 'held')
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count waitlist entries of user %d: %w", userID, err)
+	}
+	return count, nil
+}
+
+// GetUserEntries возвращает заявки пользователя, начиная с новых
+func (r *Repository) GetUserEntries(ctx context.Context, conn *pgx.Conn, userID int, limit int) ([]Entry, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+entryColumns+`
+		FROM waitlist_entries
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waitlist entries: %w", err)
+	}
+	return collectEntries(rows)
+}
+
+// GetEntryForUpdate возвращает заявку пользователя и блокирует ее до конца транзакции
+func (r *Repository) GetEntryForUpdate(ctx context.Context, conn *pgx.Conn, entryID, userID int) (Entry, error) {
+	e, err := scanEntry(conn.QueryRow(ctx, `
+		SELECT `+entryColumns+`
+		FROM waitlist_entries
+		WHERE id = $1
+		FOR UPDATE
+	`, entryID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Entry{}, domainErrors.NotFound("WAITLIST_ENTRY_NOT_FOUND", fmt.Sprintf("waitlist entry %d not found", entryID))
+		}
+		return Entry{}, fmt.Errorf("failed to get waitlist entry %d: %w", entryID, err)
+	}
+	if e.UserID != userID {
+		return Entry{}, domainErrors.Forbidden("NOT_WAITLIST_ENTRY_OWNER", "user is not the owner of the waitlist entry")
+	}
+	return e, nil
+}
+
+// GetWaitingCandidates возвращает ожидающие заявки, которым подходит освободившееся
+// время [startTime, endTime) на ресурсе resourceID, в порядке очереди.
+// Заявки блокируются; заявки, заблокированные другой транзакцией, пропускаются
+func (r *Repository) GetWaitingCandidates(ctx context.Context, conn *pgx.Conn, resourceType string, resourceID int, startTime, endTime time.Time) ([]Entry, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+entryColumns+`
+		FROM waitlist_entries
+		WHERE status = 'waiting'
+			AND resource_type = $1
+			AND (resource_id IS NULL OR resource_id = $2)
+			AND GREATEST(window_start, $3::timestamptz) + duration_minutes * interval '1 minute'
+				<= LEAST(window_end, $4::timestamptz)
+		ORDER BY created_at, id
+		FOR UPDATE SKIP LOCKED
+	`, resourceType, resourceID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query waitlist candidates: %w", err)
+	}
+	return collectEntries(rows)
+}
+
+// MarkBooked отмечает, что заявка получила подтвержденную запись
+func (r *Repository) MarkBooked(ctx context.Context, conn *pgx.Conn, entryID, bookingID int) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE waitlist_entries
+		SET status = 'booked', booking_id = $2, hold_until = NULL
+		WHERE id = $1
+	`, entryID, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to mark waitlist entry %d as booked: %w", entryID, err)
+	}
+	return nil
+}
+
+// MarkHeld отмечает, что для заявки удерживается запись до holdUntil
+func (r *Repository) MarkHeld(ctx context.Context, conn *pgx.Conn, entryID, bookingID int, holdUntil time.Time) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE waitlist_entries
+		SET status = 'held', booking_id = $2, hold_until = $3
+		WHERE id = $1
+	`, entryID, bookingID, holdUntil)
+	if err != nil {
+		return fmt.Errorf("failed to mark waitlist entry %d as held: %w", entryID, err)
+	}
+	return nil
+}
+
+// SetStatus меняет состояние заявки и отвязывает удерживаемую запись
+func (r *Repository) SetStatus(ctx context.Context, conn *pgx.Conn, entryID int, status string) error {
+	_, err := conn.Exec(ctx, `
+		UPDATE waitlist_entries
+		SET status = $2::waitlist_status, booking_id = NULL, hold_until = NULL
+		WHERE id = $1
+	`, entryID, status)
+	if err != nil {
+		return fmt.Errorf("failed to update waitlist entry %d: %w", entryID, err)
+	}
+	return nil
+}
+
+// GetExpiredHolds возвращает и блокирует заявки, время удержания которых истекло к now
+func (r *Repository) GetExpiredHolds(ctx context.Context, conn *pgx.Conn, now time.Time) ([]Entry, error) {
+	rows, err := conn.Query(ctx, `
+		SELECT `+entryColumns+`
+		FROM waitlist_entries
+		WHERE status = 'held' AND hold_until <= $1
+		ORDER BY hold_until
+		FOR UPDATE SKIP LOCKED
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired waitlist holds: %w", err)
+	}
+	return collectEntries(rows)
+}
+
+// ExpireWaiting закрывает ожидающие заявки, окно которых уже не вмещает запись.
+// Возвращает количество закрытых заявок
+func (r *Repository) ExpireWaiting(ctx context.Context, conn *pgx.Conn, now time.Time) (int, error) {
+	result, err := conn.Exec(ctx, `
+		UPDATE waitlist_entries
+		SET status = 'expired'
+		WHERE status = 'waiting'
+			AND GREATEST(window_start, $1::timestamptz) + duration_minutes * interval '1 minute' > window_end
+	`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire waitlist entries: %w", err)
+	}
+	return int(result.RowsAffected()), nil
+}
@@ -0,0 +1,311 @@
+package scheduler
+
+import (
+	"context"
+	"dormitory-helper-service/internal/logging"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// RunStatus результат запуска задачи
+type RunStatus string
+
+const (
+	RunStatusSuccess RunStatus = "success"
+	RunStatusFailed  RunStatus = "failed"
+	// RunStatusSkipped задача уже выполняется на другой реплике
+	RunStatusSkipped RunStatus = "skipped"
+)
+
+// defaultHistorySize количество последних запусков, хранимых для каждой задачи
+const defaultHistorySize = 20
+
+// Job периодическая задача
+type Job struct {
+	Name     string
+	Interval time.Duration
+	// Jitter случайная добавка к интервалу, чтобы реплики не стартовали одновременно
+	Jitter time.Duration
+	// Timeout ограничение времени одного запуска. Если не задан - равен Interval
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// Run запись об одном запуске задачи
+type Run struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Status     RunStatus
+	Error      string
+}
+
+// JobState состояние задачи для мониторинга
+type JobState struct {
+	Name        string
+	Interval    time.Duration
+	LastError   string
+	LastErrorAt *time.Time
+	// History последние запуски, от новых к старым
+	History []Run
+}
+
+type jobEntry struct {
+	job Job
+	// heartbeat время последнего срабатывания цикла задачи
+	heartbeat   time.Time
+	history     []Run
+	lastError   string
+	lastErrorAt *time.Time
+}
+
+// Scheduler запускает периодические задачи в фоне. Перед запуском задача берет
+// advisory lock в Postgres, поэтому при нескольких репликах она выполняется только на одной
+type Scheduler struct {
+	db          *pgxpool.Pool
+	historySize int
+
+	mu      sync.Mutex
+	jobs    map[string]*jobEntry
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	started bool
+}
+
+func New(db *pgxpool.Pool) *Scheduler {
+	return &Scheduler{
+		db:          db,
+		historySize: defaultHistorySize,
+		jobs:        make(map[string]*jobEntry),
+	}
+}
+
+// Add регистрирует задачу. Задачи нужно добавлять до Start
+func (s *Scheduler) Add(job Job) {
+	if job.Name == "" || job.Run == nil || job.Interval <= 0 {
+		panic(fmt.Sprintf("scheduler: invalid job %q", job.Name))
+	}
+	if job.Timeout <= 0 {
+		job.Timeout = job.Interval
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		panic("scheduler: Add called after Start")
+	}
+	if _, ok := s.jobs[job.Name]; ok {
+		panic(fmt.Sprintf("scheduler: duplicate job %q", job.Name))
+	}
+	s.jobs[job.Name] = &jobEntry{job: job}
+}
+
+// Start запускает все зарегистрированные задачи
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return
+	}
+	s.started = true
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	now := time.Now()
+	for _, entry := range s.jobs {
+		entry.heartbeat = now
+		s.wg.Add(1)
+		go s.loop(ctx, entry.job)
+	}
+}
+
+// Stop прекращает планирование новых запусков и ждет завершения текущих,
+// но не дольше, чем живет ctx
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("jobs did not finish: %w", ctx.Err())
+	}
+}
+
+// Jobs возвращает состояние всех задач
+func (s *Scheduler) Jobs() []JobState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	states := make([]JobState, 0, len(s.jobs))
+	for _, entry := range s.jobs {
+		history := make([]Run, len(entry.history))
+		for i, run := range entry.history {
+			history[len(history)-1-i] = run
+		}
+
+		states = append(states, JobState{
+			Name:        entry.job.Name,
+			Interval:    entry.job.Interval,
+			LastError:   entry.lastError,
+			LastErrorAt: entry.lastErrorAt,
+			History:     history,
+		})
+	}
+
+	sort.Slice(states, func(i, j int) bool { return states[i].Name < states[j].Name })
+	return states
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	timer := time.NewTimer(jitter(job.Jitter))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		s.beat(job.Name)
+		s.runOnce(ctx, job)
+		timer.Reset(job.Interval + jitter(job.Jitter))
+	}
+}
+
+// runOnce выполняет задачу под advisory lock. Текущий запуск не прерывается
+// при остановке планировщика, его ограничивает только Timeout
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), job.Timeout)
+	defer cancel()
+
+	// У каждого запуска свой идентификатор, как у запроса
+	runCtx = logging.NewRequestContext(runCtx, logging.NewRequestID())
+	logging.AddAttrs(runCtx, slog.String("job", job.Name))
+
+	run := Run{StartedAt: time.Now()}
+
+	locked, err := s.withLock(runCtx, job)
+	switch {
+	case err != nil:
+		run.Status = RunStatusFailed
+		run.Error = err.Error()
+		slog.ErrorContext(runCtx, "scheduler job failed", logging.Err(err))
+	case !locked:
+		run.Status = RunStatusSkipped
+	default:
+		run.Status = RunStatusSuccess
+	}
+	run.FinishedAt = time.Now()
+
+	s.record(job.Name, run)
+}
+
+// withLock берет сессионный advisory lock на отдельном соединении, выполняет
+// задачу и отпускает lock. Возвращает false, если lock занят
+func (s *Scheduler) withLock(ctx context.Context, job Job) (bool, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	lockKey := "scheduler:" + job.Name
+
+	var locked bool
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, lockKey).Scan(&locked)
+	if err != nil {
+		return false, fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+	if !locked {
+		return false, nil
+	}
+	defer func() {
+		// Контекст запуска мог истечь, lock все равно нужно отпустить
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock(hashtext($1))`, lockKey); err != nil {
+			slog.Error("failed to release scheduler lock", slog.String("job", job.Name), logging.Err(err))
+			// Соединение с висящим lock нельзя возвращать в пул
+			conn.Hijack().Close(unlockCtx)
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+func (s *Scheduler) beat(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs[name].heartbeat = time.Now()
+}
+
+// Check проверяет, что циклы всех задач живы: с последнего срабатывания прошло
+// не больше интервала, jitter и таймаута запуска
+func (s *Scheduler) Check(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return fmt.Errorf("scheduler is not started")
+	}
+
+	now := time.Now()
+	for _, entry := range s.jobs {
+		deadline := entry.heartbeat.Add(entry.job.Interval + entry.job.Jitter + entry.job.Timeout)
+		if now.After(deadline) {
+			return fmt.Errorf("job %s has not run since %s", entry.job.Name, entry.heartbeat.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+func (s *Scheduler) record(name string, run Run) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := s.jobs[name]
+	entry.history = append(entry.history, run)
+	if len(entry.history) > s.historySize {
+		entry.history = entry.history[len(entry.history)-s.historySize:]
+	}
+
+	if run.Status == RunStatusFailed {
+		at := run.FinishedAt
+		entry.lastError = run.Error
+		entry.lastErrorAt = &at
+	}
+}
+
+func jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return rand.N(max)
+}
@@ -0,0 +1,297 @@
+package adminService
+
+import (
+	"context"
+	"crypto/rand"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/metrics"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	utilsService "dormitory-helper-service/internal/service/utils"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BookingRepository interface {
+	GetResourceByID(ctx context.Context, conn *pgx.Conn, resourceID int) (bookingRepository.Resource, error)
+	GetBookingByID(ctx context.Context, conn *pgx.Conn, bookingID int) (bookingRepository.Booking, error)
+	GetBookingDetails(ctx context.Context, conn *pgx.Conn, filter bookingRepository.BookingFilter) ([]bookingRepository.BookingDetails, error)
+	UpdateBookingTime(ctx context.Context, conn *pgx.Conn, bookingID int, resource bookingRepository.Resource, startTime, endTime time.Time) error
+	DeleteBookingByID(ctx context.Context, conn *pgx.Conn, bookingID int) (bookingRepository.BookingDetails, error)
+	CreateBan(ctx context.Context, conn *pgx.Conn, ban bookingRepository.Ban) error
+	DeleteBan(ctx context.Context, conn *pgx.Conn, userID int) error
+	CreateBlackout(ctx context.Context, conn *pgx.Conn, blackout bookingRepository.Blackout) (int, error)
+	DeleteBlackout(ctx context.Context, conn *pgx.Conn, blackoutID int) error
+	GetBlackouts(ctx context.Context, conn *pgx.Conn, filter bookingRepository.BlackoutFilter) ([]bookingRepository.Blackout, error)
+	CancelBookingsInRange(ctx context.Context, conn *pgx.Conn, resourceID int, startTime, endTime time.Time, cancellation bookingRepository.Cancellation) (int, error)
+	SetCheckInToken(ctx context.Context, conn *pgx.Conn, resourceID int, token string) error
+	GetResourceForShare(ctx context.Context, conn *pgx.Conn, resourceID int) (bookingRepository.Resource, error)
+	IsOutOfOrder(ctx context.Context, conn *pgx.Conn, resourceID int) (bool, error)
+	GetOverlappingBlackout(ctx context.Context, conn *pgx.Conn, resourceID int, startTime, endTime time.Time) (*bookingRepository.Blackout, error)
+}
+
+type UserService interface {
+	SetUserRole(ctx context.Context, userId int, role string) error
+}
+
+// Waitlist лист ожидания, которому предлагается освободившееся время
+type Waitlist interface {
+	OfferFreedTime(ctx context.Context, conn *pgx.Conn, freed bookingRepository.BookingDetails) error
+	OfferVacatedTime(ctx context.Context, conn *pgx.Conn, before, after bookingRepository.Booking) error
+}
+
+// Service операции администратора. Проверка роли выполняется интерцептором
+type Service struct {
+	bookingRepo BookingRepository
+	users       UserService
+	waitlist    Waitlist
+	db          *pgxpool.Pool
+}
+
+func NewService(bookingRepo BookingRepository, users UserService, waitlist Waitlist, db *pgxpool.Pool) *Service {
+	return &Service{
+		bookingRepo: bookingRepo,
+		users:       users,
+		waitlist:    waitlist,
+		db:          db,
+	}
+}
+
+// ListAllBookings возвращает записи всех пользователей с именами владельцев
+func (s *Service) ListAllBookings(ctx context.Context, filter bookingRepository.BookingFilter) ([]bookingRepository.BookingDetails, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	bookings, err := s.bookingRepo.GetBookingDetails(ctx, conn.Conn(), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookings: %w", err)
+	}
+
+	return bookings, nil
+}
+
+// ForceDeleteBooking удаляет любую запись независимо от владельца.
+// Освободившееся время предлагается листу ожидания
+func (s *Service) ForceDeleteBooking(ctx context.Context, bookingID int) error {
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		freed, err := s.bookingRepo.DeleteBookingByID(ctx, conn.Conn(), bookingID)
+		if err != nil {
+			return err
+		}
+
+		metrics.BookingsDeleted.WithLabelValues(freed.ResourceType, metrics.DeleteReasonAdmin).Inc()
+		return s.waitlist.OfferFreedTime(ctx, conn.Conn(), freed)
+	})
+}
+
+// MoveBooking переносит любую запись на другое время и, если resourceID не равен 0, на другой
+// ресурс того же типа. Ограничения длительности и лимиты не проверяются, но пересечения
+// с другими записями, отключенные и неисправные ресурсы и закрытия запрещены.
+// Освободившееся время предлагается листу ожидания
+func (s *Service) MoveBooking(ctx context.Context, bookingID, resourceID int, startTime, endTime time.Time) error {
+	if !endTime.After(startTime) {
+		return domainErrors.Validation("INVALID_TIME_RANGE", "end time must be after start time",
+			domainErrors.FieldViolation{Field: "end_time", Description: "must be after start_time"})
+	}
+
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		booking, err := s.bookingRepo.GetBookingByID(ctx, conn.Conn(), bookingID)
+		if err != nil {
+			return err
+		}
+
+		if resourceID == 0 {
+			resourceID = booking.ResourceID
+		}
+
+		// Ресурс блокируется до конца транзакции, чтобы его не закрыли и не отключили во время переноса
+		resource, err := s.bookingRepo.GetResourceForShare(ctx, conn.Conn(), resourceID)
+		if err != nil {
+			return err
+		}
+
+		if resource.ID != booking.ResourceID {
+			current, err := s.bookingRepo.GetResourceByID(ctx, conn.Conn(), booking.ResourceID)
+			if err != nil {
+				return err
+			}
+			if resource.Type != current.Type {
+				return domainErrors.Validation("RESOURCE_TYPE_MISMATCH",
+					fmt.Sprintf("resource %d is not a %s resource", resource.ID, current.Type),
+					domainErrors.FieldViolation{Field: "resource_id", Description: "must be a resource of the same type"})
+			}
+		}
+
+		if err := s.checkResourceAvailable(ctx, conn.Conn(), resource, startTime, endTime); err != nil {
+			return err
+		}
+
+		if err := s.bookingRepo.UpdateBookingTime(ctx, conn.Conn(), bookingID, resource, startTime, endTime); err != nil {
+			return err
+		}
+
+		moved := booking
+		moved.ResourceID = resource.ID
+		moved.StartTime = startTime
+		moved.EndTime = endTime
+		return s.waitlist.OfferVacatedTime(ctx, conn.Conn(), booking, moved)
+	})
+}
+
+// checkResourceAvailable проверяет, что ресурс включен, исправен и не закрыт на выбранное время
+func (s *Service) checkResourceAvailable(ctx context.Context, conn *pgx.Conn, resource bookingRepository.Resource, startTime, endTime time.Time) error {
+	if !resource.IsActive {
+		return domainErrors.Conflict("RESOURCE_UNAVAILABLE", fmt.Sprintf("%s is not available for booking", resource.Name))
+	}
+
+	outOfOrder, err := s.bookingRepo.IsOutOfOrder(ctx, conn, resource.ID)
+	if err != nil {
+		return err
+	}
+	if outOfOrder {
+		return domainErrors.Conflict(bookingRepository.ReasonOutOfOrder, fmt.Sprintf("%s is out of order", resource.Name))
+	}
+
+	blackout, err := s.bookingRepo.GetOverlappingBlackout(ctx, conn, resource.ID, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	if blackout != nil {
+		return domainErrors.Conflict(bookingRepository.ReasonBlackedOut, fmt.Sprintf("%s is closed for the selected time", resource.Name)).
+			WithMetadata("blackout_id", strconv.Itoa(blackout.ID))
+	}
+
+	return nil
+}
+
+// BanUser запрещает пользователю создавать записи. bannedUntil = nil - бессрочно
+func (s *Service) BanUser(ctx context.Context, adminID, userID int, reason string, bannedUntil *time.Time) error {
+	if adminID == userID {
+		return domainErrors.Validation("CANNOT_BAN_SELF", "administrator cannot ban themselves",
+			domainErrors.FieldViolation{Field: "user_id", Description: "must differ from the caller"})
+	}
+
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		return s.bookingRepo.CreateBan(ctx, conn.Conn(), bookingRepository.Ban{
+			UserID:      userID,
+			Reason:      reason,
+			BannedUntil: bannedUntil,
+			CreatedBy:   adminID,
+		})
+	})
+}
+
+// UnbanUser снимает запрет на бронирование
+func (s *Service) UnbanUser(ctx context.Context, userID int) error {
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		return s.bookingRepo.DeleteBan(ctx, conn.Conn(), userID)
+	})
+}
+
+// SetUserRole повышает пользователя до администратора или понижает до обычного пользователя.
+// Администратор не может понизить сам себя, чтобы не остаться без администраторов
+func (s *Service) SetUserRole(ctx context.Context, adminID, userID int, role string) error {
+	if adminID == userID {
+		return domainErrors.Validation("CANNOT_CHANGE_OWN_ROLE", "administrator cannot change their own role",
+			domainErrors.FieldViolation{Field: "user_id", Description: "must differ from the caller"})
+	}
+
+	return s.users.SetUserRole(ctx, userID, role)
+}
+
+// CreateBlackout закрывает ресурс на период. Если cancelBookings = true, записи,
+// пересекающие период, отменяются с причиной закрытия и сохраняются в архиве отмен.
+// Возвращает ID закрытия и количество отмененных записей
+func (s *Service) CreateBlackout(ctx context.Context, blackout bookingRepository.Blackout, cancelBookings bool) (int, int, error) {
+	if !blackout.EndTime.After(blackout.StartTime) {
+		return 0, 0, domainErrors.Validation("INVALID_TIME_RANGE", "end time must be after start time",
+			domainErrors.FieldViolation{Field: "end_time", Description: "must be after start_time"})
+	}
+
+	var blackoutID, cancelled int
+	var resourceType string
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		resource, err := s.bookingRepo.GetResourceByID(ctx, conn.Conn(), blackout.ResourceID)
+		if err != nil {
+			return err
+		}
+		resourceType = resource.Type
+
+		blackoutID, err = s.bookingRepo.CreateBlackout(ctx, conn.Conn(), blackout)
+		if err != nil {
+			return err
+		}
+
+		if !cancelBookings {
+			return nil
+		}
+
+		reason := "resource closed"
+		if blackout.Reason != "" {
+			reason = blackout.Reason
+		}
+
+		cancelled, err = s.bookingRepo.CancelBookingsInRange(ctx, conn.Conn(), blackout.ResourceID, blackout.StartTime, blackout.EndTime,
+			bookingRepository.Cancellation{
+				Reason:      reason,
+				BlackoutID:  blackoutID,
+				CancelledBy: blackout.CreatedBy,
+			})
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	metrics.BookingsDeleted.WithLabelValues(resourceType, metrics.DeleteReasonBlackout).Add(float64(cancelled))
+	return blackoutID, cancelled, nil
+}
+
+// DeleteBlackout снимает закрытие ресурса. Отмененные записи не восстанавливаются
+func (s *Service) DeleteBlackout(ctx context.Context, blackoutID int) error {
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		return s.bookingRepo.DeleteBlackout(ctx, conn.Conn(), blackoutID)
+	})
+}
+
+// ListBlackouts возвращает закрытия ресурсов, удовлетворяющие фильтру
+func (s *Service) ListBlackouts(ctx context.Context, filter bookingRepository.BlackoutFilter) ([]bookingRepository.Blackout, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	blackouts, err := s.bookingRepo.GetBlackouts(ctx, conn.Conn(), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blackouts: %w", err)
+	}
+
+	return blackouts, nil
+}
+
+// RotateCheckInToken выдает ресурсу новый токен для QR-кода отметки о приходе.
+// Прежний QR-код перестает действовать
+func (s *Service) RotateCheckInToken(ctx context.Context, resourceID int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate check-in token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		return s.bookingRepo.SetCheckInToken(ctx, conn.Conn(), resourceID, token)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,250 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/metrics"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	utilsService "dormitory-helper-service/internal/service/utils"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// checkInEarly насколько раньше начала записи можно отметиться о приходе
+const checkInEarly = 10 * time.Minute
+
+// NoShowPolicy ограничение на бронирование за неявки
+type NoShowPolicy struct {
+	// Limit после скольких неявок за Window бронирование запрещается на Ban. 0 - без ограничения
+	Limit  int
+	Window time.Duration
+	Ban    time.Duration
+}
+
+// CheckIn отмечает приход пользователя на запись. Если bookingID равен 0, запись ищется
+// по токену из QR-кода ресурса среди текущих записей пользователя на этот ресурс.
+// Если переданы оба, токен должен относиться к ресурсу записи. Если resourceType
+// не пустой, отмечаться можно только на записи на ресурсы этого типа
+func (s *Service) CheckIn(ctx context.Context, userID, bookingID int, resourceType, token string) (bookingRepository.Booking, error) {
+	if bookingID == 0 && token == "" {
+		return bookingRepository.Booking{}, domainErrors.Validation("CHECK_IN_TARGET_REQUIRED", "booking_id or check-in code is required",
+			domainErrors.FieldViolation{Field: "booking_id", Description: "booking_id or check_in_token is required"},
+			domainErrors.FieldViolation{Field: "check_in_token", Description: "booking_id or check_in_token is required"})
+	}
+
+	var booking bookingRepository.Booking
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		now := time.Now()
+
+		var tokenResource *bookingRepository.Resource
+		if token != "" {
+			resource, err := s.repo.GetResourceByCheckInToken(ctx, conn.Conn(), token)
+			if err != nil {
+				return err
+			}
+			tokenResource = &resource
+		}
+
+		var err error
+		if bookingID == 0 {
+			booking, err = s.findCheckInBooking(ctx, conn.Conn(), userID, tokenResource.ID, now)
+		} else {
+			booking, err = s.getOwnBooking(ctx, conn.Conn(), bookingID, userID, resourceType)
+		}
+		if err != nil {
+			return err
+		}
+
+		if tokenResource != nil && tokenResource.ID != booking.ResourceID {
+			return domainErrors.Forbidden("CHECK_IN_TOKEN_MISMATCH", "check-in code belongs to another resource")
+		}
+
+		if opensAt := booking.StartTime.Add(-checkInEarly); now.Before(opensAt) {
+			return domainErrors.Conflict("CHECK_IN_TOO_EARLY",
+				fmt.Sprintf("check-in opens %s before the booking starts", formatMinutes(int(checkInEarly/time.Minute)))).
+				WithMetadata("opens_at", opensAt.UTC().Format(time.RFC3339))
+		}
+		if !booking.EndTime.After(now) {
+			return domainErrors.Conflict("BOOKING_ENDED", "booking has already ended")
+		}
+
+		if err := s.repo.CheckIn(ctx, conn.Conn(), booking.ID, now); err != nil {
+			return err
+		}
+
+		booking.CheckedInAt = &now
+		return nil
+	})
+
+	return booking, err
+}
+
+// findCheckInBooking ищет запись пользователя на ресурс, на которую уже можно отметиться
+func (s *Service) findCheckInBooking(ctx context.Context, conn *pgx.Conn, userID, resourceID int, now time.Time) (bookingRepository.Booking, error) {
+	opensBefore := now.Add(checkInEarly)
+	bookings, err := s.repo.GetBookings(ctx, conn, bookingRepository.BookingFilter{
+		ResourceID:  resourceID,
+		UserID:      userID,
+		StartBefore: &opensBefore,
+	})
+	if err != nil {
+		return bookingRepository.Booking{}, fmt.Errorf("failed to get bookings: %w", err)
+	}
+
+	for _, b := range bookings {
+		if b.EndTime.After(now) && b.CheckedInAt == nil {
+			return b, nil
+		}
+	}
+
+	return bookingRepository.Booking{}, domainErrors.NotFound("NO_BOOKING_TO_CHECK_IN", "there is no current booking to check in on this resource")
+}
+
+// CheckOut отмечает уход пользователя. Если запись еще идет, она заканчивается сейчас,
+// а оставшееся время предлагается листу ожидания
+func (s *Service) CheckOut(ctx context.Context, userID, bookingID int, resourceType string) (bookingRepository.Booking, error) {
+	var booking bookingRepository.Booking
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		var err error
+		booking, err = s.getOwnBooking(ctx, conn.Conn(), bookingID, userID, resourceType)
+		if err != nil {
+			return err
+		}
+
+		if booking.CheckedInAt == nil {
+			return domainErrors.Conflict("NOT_CHECKED_IN", "booking is not checked in")
+		}
+		if booking.CheckedOutAt != nil {
+			return domainErrors.Conflict("ALREADY_CHECKED_OUT", "booking is already checked out")
+		}
+
+		now := time.Now()
+		if err := s.repo.CheckOut(ctx, conn.Conn(), booking.ID, now); err != nil {
+			return err
+		}
+
+		booking.CheckedOutAt = &now
+		if !now.After(booking.StartTime) || !now.Before(booking.EndTime) {
+			return nil
+		}
+
+		resource, err := s.repo.GetResourceByID(ctx, conn.Conn(), booking.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		freed := bookingRepository.BookingDetails{
+			Booking:      bookingRepository.Booking{ID: booking.ID, ResourceID: booking.ResourceID, UserID: userID, StartTime: now, EndTime: booking.EndTime},
+			ResourceType: resource.Type,
+			ResourceName: resource.Name,
+		}
+		booking.EndTime = now
+		return s.offerFreedTime(ctx, conn.Conn(), freed)
+	})
+
+	return booking, err
+}
+
+// getOwnBooking возвращает запись пользователя. Если resourceType не пустой,
+// записи на ресурсы другого типа считаются несуществующими
+func (s *Service) getOwnBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int, resourceType string) (bookingRepository.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, conn, bookingID)
+	if err != nil {
+		return bookingRepository.Booking{}, err
+	}
+
+	if resourceType != "" {
+		resource, err := s.repo.GetResourceByID(ctx, conn, booking.ResourceID)
+		if err != nil {
+			return bookingRepository.Booking{}, err
+		}
+		if resource.Type != resourceType {
+			return bookingRepository.Booking{}, domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+		}
+	}
+
+	if booking.UserID != userID {
+		return bookingRepository.Booking{}, domainErrors.Forbidden("NOT_BOOKING_OWNER", "user is not the owner of the booking")
+	}
+
+	return booking, nil
+}
+
+// ReleaseNoShows освобождает записи, на которые не отметились вовремя, предлагает
+// их время листу ожидания и запрещает бронирование пользователям, превысившим
+// лимит неявок. Возвращает количество освобожденных записей
+func (s *Service) ReleaseNoShows(ctx context.Context) (int, error) {
+	var released int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		now := time.Now()
+
+		bookings, err := s.repo.ReleaseNoShows(ctx, conn.Conn(), now)
+		if err != nil {
+			return err
+		}
+
+		users := make(map[int]bool)
+		for _, b := range bookings {
+			metrics.BookingsDeleted.WithLabelValues(b.ResourceType, metrics.DeleteReasonNoShow).Inc()
+			if err := s.offerFreedTime(ctx, conn.Conn(), b); err != nil {
+				return err
+			}
+			if b.UserID != 0 {
+				users[b.UserID] = true
+			}
+		}
+
+		for userID := range users {
+			if err := s.restrictNoShows(ctx, conn.Conn(), userID, now); err != nil {
+				return err
+			}
+		}
+
+		released = len(bookings)
+		return nil
+	})
+
+	return released, err
+}
+
+// restrictNoShows запрещает пользователю бронирование на s.noShow.Ban, если за
+// s.noShow.Window у него набралось s.noShow.Limit неявок. Действующий запрет
+// (например, бессрочный от администратора) не заменяется
+func (s *Service) restrictNoShows(ctx context.Context, conn *pgx.Conn, userID int, now time.Time) error {
+	if s.noShow.Limit == 0 {
+		return nil
+	}
+
+	count, err := s.repo.CountNoShows(ctx, conn, userID, now.Add(-s.noShow.Window))
+	if err != nil {
+		return err
+	}
+	if count < s.noShow.Limit {
+		return nil
+	}
+
+	ban, err := s.repo.GetActiveBan(ctx, conn, userID)
+	if err != nil {
+		return err
+	}
+	if ban != nil {
+		return nil
+	}
+
+	bannedUntil := now.Add(s.noShow.Ban)
+	if err := s.repo.CreateBan(ctx, conn, bookingRepository.Ban{
+		UserID:      userID,
+		Reason:      fmt.Sprintf("%d missed bookings without check-in", count),
+		BannedUntil: &bannedUntil,
+	}); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "user banned for no-shows",
+		slog.Int("user_id", userID), slog.Int("no_shows", count), slog.Time("banned_until", bannedUntil))
+	return nil
+}
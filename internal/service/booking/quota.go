@@ -0,0 +1,164 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// QuotaStatus лимиты пользователя и их использование за текущие день и неделю
+type QuotaStatus struct {
+	Quota     bookingRepository.Quota
+	Usage     bookingRepository.QuotaUsage
+	DayStart  time.Time
+	WeekStart time.Time
+	// NoShows неявки за NoShowPolicy.Window по записям на любые помещения
+	NoShows      int
+	NoShowPolicy NoShowPolicy
+}
+
+// GetMyQuota возвращает лимиты бронирования ресурсов типа resourceType
+// и их использование пользователем на сегодня по времени общежития
+func (s *Service) GetMyQuota(ctx context.Context, userID int, resourceType string) (QuotaStatus, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return QuotaStatus{}, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	resources, err := s.repo.GetResources(ctx, conn.Conn(), resourceType)
+	if err != nil {
+		return QuotaStatus{}, fmt.Errorf("failed to get resources: %w", err)
+	}
+	if len(resources) == 0 {
+		return QuotaStatus{}, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("no active %s resource configured", resourceType))
+	}
+
+	quota, err := s.repo.GetQuota(ctx, conn.Conn(), resourceType)
+	if err != nil {
+		return QuotaStatus{}, err
+	}
+
+	now := time.Now()
+	dayStart, dayEnd := s.dayBounds(now)
+	weekStart, weekEnd := s.weekBounds(now)
+
+	usage, err := s.repo.GetQuotaUsage(ctx, conn.Conn(), userID, resourceType, now, dayStart, dayEnd, weekStart, weekEnd, 0)
+	if err != nil {
+		return QuotaStatus{}, err
+	}
+
+	if quota.MaxAdvanceMinutes == 0 {
+		quota.MaxAdvanceMinutes = int(s.maxAdvance / time.Minute)
+	}
+
+	var noShows int
+	if s.noShow.Limit > 0 {
+		noShows, err = s.repo.CountNoShows(ctx, conn.Conn(), userID, now.Add(-s.noShow.Window))
+		if err != nil {
+			return QuotaStatus{}, err
+		}
+	}
+
+	return QuotaStatus{
+		Quota:        quota,
+		Usage:        usage,
+		DayStart:     dayStart,
+		WeekStart:    weekStart,
+		NoShows:      noShows,
+		NoShowPolicy: s.noShow,
+	}, nil
+}
+
+// checkQuota проверяет лимиты пользователя для новой или измененной записи (excludeBookingID,
+// 0 для новой). Вызывается в транзакции после блокировки пользователя (LockUser),
+// поэтому параллельные запросы одного пользователя не могут вместе превысить лимит
+func (s *Service) checkQuota(ctx context.Context, conn *pgx.Conn, userID int, resource bookingRepository.Resource, startTime, endTime time.Time, excludeBookingID int) error {
+	quota, err := s.repo.GetQuota(ctx, conn, resource.Type)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if maxAdvance := s.maxAdvanceFor(quota); maxAdvance > 0 && startTime.After(now.Add(maxAdvance)) {
+		return domainErrors.Validation("TOO_FAR_IN_ADVANCE",
+			fmt.Sprintf("%s bookings can be made at most %s in advance", resource.Type, formatMinutes(int(maxAdvance.Minutes()))),
+			domainErrors.FieldViolation{Field: "start_time", Description: "is too far in the future"})
+	}
+
+	dayStart, dayEnd := s.dayBounds(startTime)
+	weekStart, weekEnd := s.weekBounds(startTime)
+
+	usage, err := s.repo.GetQuotaUsage(ctx, conn, userID, resource.Type, now, dayStart, dayEnd, weekStart, weekEnd, excludeBookingID)
+	if err != nil {
+		return err
+	}
+
+	minutes := int(endTime.Sub(startTime) / time.Minute)
+
+	if limit := quota.MaxActiveBookings; limit > 0 && usage.ActiveBookings >= limit {
+		return domainErrors.QuotaExceeded("MAX_ACTIVE_BOOKINGS",
+			fmt.Sprintf("at most %d active %s bookings are allowed", limit, resource.Type)).
+			WithMetadata("limit", strconv.Itoa(limit))
+	}
+
+	if limit := quota.MaxMinutesPerDay; limit > 0 && usage.DayMinutes+minutes > limit {
+		return domainErrors.QuotaExceeded("DAILY_LIMIT_EXCEEDED",
+			fmt.Sprintf("%s bookings cannot exceed %s per day", resource.Type, formatMinutes(limit))).
+			WithMetadata("limit_minutes", strconv.Itoa(limit)).
+			WithMetadata("remaining_minutes", strconv.Itoa(max(limit-usage.DayMinutes, 0)))
+	}
+
+	if limit := quota.MaxMinutesPerWeek; limit > 0 && usage.WeekMinutes+minutes > limit {
+		return domainErrors.QuotaExceeded("WEEKLY_LIMIT_EXCEEDED",
+			fmt.Sprintf("%s bookings cannot exceed %s per week", resource.Type, formatMinutes(limit))).
+			WithMetadata("limit_minutes", strconv.Itoa(limit)).
+			WithMetadata("remaining_minutes", strconv.Itoa(max(limit-usage.WeekMinutes, 0)))
+	}
+
+	if quota.MinGapMinutes > 0 {
+		gap := time.Duration(quota.MinGapMinutes) * time.Minute
+		tooClose, err := s.repo.HasUserBookingBetween(ctx, conn, userID, resource.Type, startTime.Add(-gap), endTime.Add(gap), excludeBookingID)
+		if err != nil {
+			return err
+		}
+		if tooClose {
+			return domainErrors.QuotaExceeded("BOOKING_GAP_TOO_SHORT",
+				fmt.Sprintf("there must be at least %s between your %s bookings", formatMinutes(quota.MinGapMinutes), resource.Type)).
+				WithMetadata("min_gap_minutes", strconv.Itoa(quota.MinGapMinutes))
+		}
+	}
+
+	return nil
+}
+
+// maxAdvanceFor возвращает, насколько далеко вперед можно записаться на ресурсы
+// с лимитами quota. 0 - без ограничения
+func (s *Service) maxAdvanceFor(quota bookingRepository.Quota) time.Duration {
+	if quota.MaxAdvanceMinutes > 0 {
+		return time.Duration(quota.MaxAdvanceMinutes) * time.Minute
+	}
+	return s.maxAdvance
+}
+
+// dayBounds возвращает начало дня, в который попадает t, и начало следующего дня
+// по времени общежития
+func (s *Service) dayBounds(t time.Time) (time.Time, time.Time) {
+	year, month, day := t.In(s.location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, s.location), time.Date(year, month, day+1, 0, 0, 0, 0, s.location)
+}
+
+// weekBounds возвращает начало недели (понедельник), в которую попадает t,
+// и начало следующей недели по времени общежития
+func (s *Service) weekBounds(t time.Time) (time.Time, time.Time) {
+	local := t.In(s.location)
+	year, month, day := local.Date()
+	monday := day - (int(local.Weekday())+6)%7
+	return time.Date(year, month, monday, 0, 0, 0, 0, s.location), time.Date(year, month, monday+7, 0, 0, 0, 0, s.location)
+}
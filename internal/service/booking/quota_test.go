@@ -0,0 +1,90 @@
+package bookingService
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayAndWeekBoundsAcrossDST(t *testing.T) {
+	s := &Service{location: loadBerlin(t)}
+
+	tests := []struct {
+		name         string
+		at           string
+		wantDayStart string
+		wantDayEnd   string
+		wantDayLen   time.Duration
+		wantWeekFrom string
+		wantWeekTo   string
+		wantWeekLen  time.Duration
+	}{
+		{
+			name:         "spring forward",
+			at:           "2026-03-29T12:00:00+02:00",
+			wantDayStart: "2026-03-29T00:00:00+01:00",
+			wantDayEnd:   "2026-03-30T00:00:00+02:00",
+			wantDayLen:   23 * time.Hour,
+			wantWeekFrom: "2026-03-23T00:00:00+01:00",
+			wantWeekTo:   "2026-03-30T00:00:00+02:00",
+			wantWeekLen:  7*24*time.Hour - time.Hour,
+		},
+		{
+			name:         "spring forward, UTC before local midnight",
+			at:           "2026-03-28T23:30:00Z",
+			wantDayStart: "2026-03-29T00:00:00+01:00",
+			wantDayEnd:   "2026-03-30T00:00:00+02:00",
+			wantDayLen:   23 * time.Hour,
+			wantWeekFrom: "2026-03-23T00:00:00+01:00",
+			wantWeekTo:   "2026-03-30T00:00:00+02:00",
+			wantWeekLen:  7*24*time.Hour - time.Hour,
+		},
+		{
+			name:         "fall back",
+			at:           "2026-10-25T12:00:00+01:00",
+			wantDayStart: "2026-10-25T00:00:00+02:00",
+			wantDayEnd:   "2026-10-26T00:00:00+01:00",
+			wantDayLen:   25 * time.Hour,
+			wantWeekFrom: "2026-10-19T00:00:00+02:00",
+			wantWeekTo:   "2026-10-26T00:00:00+01:00",
+			wantWeekLen:  7*24*time.Hour + time.Hour,
+		},
+		{
+			name:         "fall back, repeated hour",
+			at:           "2026-10-25T02:30:00+01:00",
+			wantDayStart: "2026-10-25T00:00:00+02:00",
+			wantDayEnd:   "2026-10-26T00:00:00+01:00",
+			wantDayLen:   25 * time.Hour,
+			wantWeekFrom: "2026-10-19T00:00:00+02:00",
+			wantWeekTo:   "2026-10-26T00:00:00+01:00",
+			wantWeekLen:  7*24*time.Hour + time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := mustParse(t, tt.at)
+
+			dayStart, dayEnd := s.dayBounds(at)
+			if want := mustParse(t, tt.wantDayStart); !dayStart.Equal(want) {
+				t.Errorf("day start = %v, want %v", dayStart, want)
+			}
+			if want := mustParse(t, tt.wantDayEnd); !dayEnd.Equal(want) {
+				t.Errorf("day end = %v, want %v", dayEnd, want)
+			}
+			if got := dayEnd.Sub(dayStart); got != tt.wantDayLen {
+				t.Errorf("day length = %v, want %v", got, tt.wantDayLen)
+			}
+
+			weekStart, weekEnd := s.weekBounds(at)
+			if want := mustParse(t, tt.wantWeekFrom); !weekStart.Equal(want) {
+				t.Errorf("week start = %v, want %v", weekStart, want)
+			}
+			if want := mustParse(t, tt.wantWeekTo); !weekEnd.Equal(want) {
+				t.Errorf("week end = %v, want %v", weekEnd, want)
+			}
+			if got := weekEnd.Sub(weekStart); got != tt.wantWeekLen {
+				t.Errorf("week length = %v, want %v", got, tt.wantWeekLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// defaultScheduleDays и maxScheduleDays количество дней в расписании помещения
+const (
+	defaultScheduleDays = 7
+	maxScheduleDays     = 31
+)
+
+// Period промежуток времени [Start, End)
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// FacilitySchedule расписание помещения на несколько дней
+type FacilitySchedule struct {
+	OpeningHours []bookingRepository.FacilityHours
+	QuietHours   []bookingRepository.FacilityHours
+	// ClosedPeriods периоды в запрошенном диапазоне, когда записаться нельзя
+	ClosedPeriods []Period
+}
+
+// GetFacilitySchedule возвращает недельное расписание помещений типа resourceType
+// и закрытые периоды на days дней начиная с fromDate (YYYY-MM-DD, по умолчанию сегодня)
+func (s *Service) GetFacilitySchedule(ctx context.Context, resourceType, fromDate string, days int) (FacilitySchedule, error) {
+	if days == 0 {
+		days = defaultScheduleDays
+	}
+	if days < 0 || days > maxScheduleDays {
+		message := fmt.Sprintf("days must be between 1 and %d", maxScheduleDays)
+		return FacilitySchedule{}, domainErrors.Validation("INVALID_DAYS", message,
+			domainErrors.FieldViolation{Field: "days", Description: message})
+	}
+
+	var from time.Time
+	if fromDate == "" {
+		from, _ = s.dayBounds(time.Now())
+	} else {
+		var err error
+		from, _, err = s.DayRange(fromDate)
+		if err != nil {
+			return FacilitySchedule{}, err
+		}
+	}
+	year, month, day := from.Date()
+	to := time.Date(year, month, day+days, 0, 0, 0, 0, s.location)
+
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return FacilitySchedule{}, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	resources, err := s.repo.GetResources(ctx, conn.Conn(), resourceType)
+	if err != nil {
+		return FacilitySchedule{}, fmt.Errorf("failed to get resources: %w", err)
+	}
+	if len(resources) == 0 {
+		return FacilitySchedule{}, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("no active %s resource configured", resourceType))
+	}
+
+	hours, err := s.repo.GetFacilityHours(ctx, conn.Conn(), resourceType)
+	if err != nil {
+		return FacilitySchedule{}, err
+	}
+
+	open, quiet := splitHours(hours)
+	return FacilitySchedule{
+		OpeningHours:  open,
+		QuietHours:    quiet,
+		ClosedPeriods: closedPeriods(open, quiet, from, to, s.location),
+	}, nil
+}
+
+// checkFacilityHours проверяет, что запись не пересекает тихие часы
+// и целиком помещается в часы работы помещения
+func checkFacilityHours(resource bookingRepository.Resource, hours []bookingRepository.FacilityHours, startTime, endTime time.Time, location *time.Location) error {
+	open, quiet := splitHours(hours)
+
+	if periods := expandHours(quiet, startTime, endTime, location); len(periods) > 0 {
+		message := fmt.Sprintf("%s cannot be booked during quiet hours (%s-%s)", resource.Type,
+			periods[0].Start.In(location).Format("15:04"), periods[0].End.In(location).Format("15:04"))
+		return domainErrors.Validation("QUIET_HOURS", message,
+			domainErrors.FieldViolation{Field: "start_time", Description: message},
+			domainErrors.FieldViolation{Field: "end_time", Description: message})
+	}
+
+	if len(open) == 0 {
+		return nil
+	}
+
+	for _, p := range expandHours(open, startTime, endTime, location) {
+		if !p.Start.After(startTime) && !p.End.Before(endTime) {
+			return nil
+		}
+	}
+
+	message := fmt.Sprintf("%s booking must be within opening hours", resource.Type)
+	return domainErrors.Validation("OUTSIDE_OPENING_HOURS", message,
+		domainErrors.FieldViolation{Field: "start_time", Description: message},
+		domainErrors.FieldViolation{Field: "end_time", Description: message})
+}
+
+// splitHours разделяет окна расписания на часы работы и тихие часы
+func splitHours(hours []bookingRepository.FacilityHours) (open, quiet []bookingRepository.FacilityHours) {
+	for _, h := range hours {
+		switch h.Kind {
+		case bookingRepository.HoursKindOpen:
+			open = append(open, h)
+		case bookingRepository.HoursKindQuiet:
+			quiet = append(quiet, h)
+		}
+	}
+	return open, quiet
+}
+
+// expandHours разворачивает недельные окна в конкретные периоды, пересекающие [from, to),
+// и объединяет пересекающиеся. Окна строятся по показаниям местных часов, поэтому
+// в дни перехода на летнее время окно 23:00-07:00 длится 7 или 9 часов.
+// Перебор начинается с предыдущего дня, чтобы учесть окна, переходящие через полночь
+func expandHours(hours []bookingRepository.FacilityHours, from, to time.Time, location *time.Location) []Period {
+	var periods []Period
+
+	year, month, day := from.In(location).Date()
+	for date := time.Date(year, month, day-1, 0, 0, 0, 0, location); date.Before(to); date = date.AddDate(0, 0, 1) {
+		for _, h := range hours {
+			if h.Weekday != date.Weekday() {
+				continue
+			}
+
+			endDate := date
+			if h.End <= h.Start {
+				endDate = date.AddDate(0, 0, 1)
+			}
+
+			p := Period{Start: atClock(date, h.Start, location), End: atClock(endDate, h.End, location)}
+			if p.Start.Before(to) && p.End.After(from) {
+				periods = append(periods, p)
+			}
+		}
+	}
+
+	return mergePeriods(periods)
+}
+
+// atClock возвращает момент, когда местные часы в день date показывают offset от полуночи
+func atClock(date time.Time, offset time.Duration, location *time.Location) time.Time {
+	year, month, day := date.Date()
+	minutes := int(offset / time.Minute)
+	return time.Date(year, month, day, minutes/60, minutes%60, 0, 0, location)
+}
+
+// mergePeriods сортирует периоды и объединяет пересекающиеся и смежные
+func mergePeriods(periods []Period) []Period {
+	if len(periods) == 0 {
+		return nil
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].Start.Before(periods[j].Start)
+	})
+
+	merged := []Period{periods[0]}
+	for _, p := range periods[1:] {
+		last := &merged[len(merged)-1]
+		if p.Start.After(last.End) {
+			merged = append(merged, p)
+			continue
+		}
+		if p.End.After(last.End) {
+			last.End = p.End
+		}
+	}
+
+	return merged
+}
+
+// closedPeriods возвращает периоды внутри [from, to), когда помещение закрыто
+// или действуют тихие часы
+func closedPeriods(open, quiet []bookingRepository.FacilityHours, from, to time.Time, location *time.Location) []Period {
+	var closed []Period
+
+	if len(open) > 0 {
+		// Промежутки между часами работы
+		cursor := from
+		for _, p := range expandHours(open, from, to, location) {
+			if p.Start.After(cursor) {
+				closed = append(closed, Period{Start: cursor, End: p.Start})
+			}
+			if p.End.After(cursor) {
+				cursor = p.End
+			}
+		}
+		if cursor.Before(to) {
+			closed = append(closed, Period{Start: cursor, End: to})
+		}
+	}
+
+	closed = append(closed, expandHours(quiet, from, to, location)...)
+
+	closed = mergePeriods(closed)
+	for i := range closed {
+		if closed[i].Start.Before(from) {
+			closed[i].Start = from
+		}
+		if closed[i].End.After(to) {
+			closed[i].End = to
+		}
+	}
+
+	return closed
+}
@@ -0,0 +1,77 @@
+package bookingService
+
+import (
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"testing"
+	"time"
+)
+
+func TestExpandHoursOvernightAcrossDST(t *testing.T) {
+	berlin := loadBerlin(t)
+
+	// Тихие часы в ночь с субботы на воскресенье
+	hours := []bookingRepository.FacilityHours{
+		{Weekday: time.Saturday, Start: 23 * time.Hour, End: 7 * time.Hour},
+	}
+
+	tests := []struct {
+		name         string
+		from, to     string
+		wantStart    string
+		wantEnd      string
+		wantDuration time.Duration
+	}{
+		{
+			name:         "spring forward",
+			from:         "2026-03-28T12:00:00+01:00",
+			to:           "2026-03-29T12:00:00+02:00",
+			wantStart:    "2026-03-28T23:00:00+01:00",
+			wantEnd:      "2026-03-29T07:00:00+02:00",
+			wantDuration: 7 * time.Hour,
+		},
+		{
+			name:         "spring forward, from after midnight",
+			from:         "2026-03-29T01:00:00+01:00",
+			to:           "2026-03-29T05:00:00+02:00",
+			wantStart:    "2026-03-28T23:00:00+01:00",
+			wantEnd:      "2026-03-29T07:00:00+02:00",
+			wantDuration: 7 * time.Hour,
+		},
+		{
+			name:         "fall back",
+			from:         "2026-10-24T12:00:00+02:00",
+			to:           "2026-10-25T12:00:00+01:00",
+			wantStart:    "2026-10-24T23:00:00+02:00",
+			wantEnd:      "2026-10-25T07:00:00+01:00",
+			wantDuration: 9 * time.Hour,
+		},
+		{
+			name:         "fall back, from in repeated hour",
+			from:         "2026-10-25T02:30:00+01:00",
+			to:           "2026-10-25T04:00:00+01:00",
+			wantStart:    "2026-10-24T23:00:00+02:00",
+			wantEnd:      "2026-10-25T07:00:00+01:00",
+			wantDuration: 9 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := expandHours(hours, mustParse(t, tt.from), mustParse(t, tt.to), berlin)
+			if len(periods) != 1 {
+				t.Fatalf("got %d periods, want 1: %v", len(periods), periods)
+			}
+
+			p := periods[0]
+			if want := mustParse(t, tt.wantStart); !p.Start.Equal(want) {
+				t.Errorf("start = %v, want %v", p.Start, want)
+			}
+			if want := mustParse(t, tt.wantEnd); !p.End.Equal(want) {
+				t.Errorf("end = %v, want %v", p.End, want)
+			}
+			if got := p.End.Sub(p.Start); got != tt.wantDuration {
+				t.Errorf("duration = %v, want %v", got, tt.wantDuration)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/metrics"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	utilsService "dormitory-helper-service/internal/service/utils"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// maxSeriesOccurrences сколько повторений может быть в одной серии
+	maxSeriesOccurrences = 26
+	// seriesHistoryLimit сколько последних серий возвращается пользователю
+	seriesHistoryLimit = 20
+)
+
+// Что отменить в серии повторяющихся записей
+const (
+	// SeriesScopeOccurrence одно повторение
+	SeriesScopeOccurrence = "occurrence"
+	// SeriesScopeFollowing повторение и все следующие за ним
+	SeriesScopeFollowing = "following"
+	// SeriesScopeAll все еще не начавшиеся повторения
+	SeriesScopeAll = "all"
+)
+
+// SeriesOccurrence результат создания одного повторения серии.
+// Если записаться не удалось, BookingID = 0, а Err содержит причину
+type SeriesOccurrence struct {
+	StartTime time.Time
+	EndTime   time.Time
+	BookingID int
+	Err       *domainErrors.Error
+}
+
+// SeriesDetails серия и ее записи
+type SeriesDetails struct {
+	bookingRepository.Series
+	Bookings []bookingRepository.Booking
+}
+
+// CreateSeries создает серию повторяющихся записей и сразу записывает на все повторения.
+// Каждое повторение проходит те же проверки, что и обычная запись (пересечения, лимиты,
+// часы работы, закрытия). Повторения, на которые записаться не удалось, возвращаются
+// с причиной, а серия создается из остальных. Если не удалось ни одно, серия не создается
+func (s *Service) CreateSeries(ctx context.Context, series bookingRepository.Series) (int, []SeriesOccurrence, error) {
+	if series.IntervalWeeks == 0 {
+		series.IntervalWeeks = 1
+	}
+	if err := validateSeries(series); err != nil {
+		return 0, nil, err
+	}
+
+	periods := seriesOccurrences(series, s.location)
+	if len(periods) > maxSeriesOccurrences {
+		message := fmt.Sprintf("series cannot have more than %d occurrences", maxSeriesOccurrences)
+		return 0, nil, domainErrors.Validation("SERIES_TOO_LONG", message,
+			domainErrors.FieldViolation{Field: "until_date", Description: message})
+	}
+
+	var seriesID int
+	var occurrences []SeriesOccurrence
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		occurrences = make([]SeriesOccurrence, 0, len(periods))
+
+		resource, err := s.repo.GetResourceByID(ctx, conn.Conn(), series.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkNotBanned(ctx, conn.Conn(), series.UserID); err != nil {
+			return err
+		}
+
+		seriesID, err = s.repo.CreateSeries(ctx, conn.Conn(), series)
+		if err != nil {
+			return err
+		}
+
+		var bookingIDs []int
+		for _, p := range periods {
+			occurrence := SeriesOccurrence{StartTime: p.Start, EndTime: p.End}
+
+			bookingID, err := s.tryCreateBooking(ctx, conn.Conn(), series.UserID, resource, p.Start, p.End)
+			if err != nil {
+				domainErr, ok := domainErrors.As(err)
+				if !ok {
+					return err
+				}
+				occurrence.Err = domainErr
+			} else {
+				occurrence.BookingID = bookingID
+				bookingIDs = append(bookingIDs, bookingID)
+			}
+
+			occurrences = append(occurrences, occurrence)
+		}
+
+		if len(bookingIDs) == 0 {
+			return seriesNotCreatedError(occurrences)
+		}
+
+		return s.repo.AttachBookingsToSeries(ctx, conn.Conn(), seriesID, bookingIDs)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return seriesID, occurrences, nil
+}
+
+// validateSeries проверяет правило повторения серии
+func validateSeries(series bookingRepository.Series) error {
+	if !series.EndTime.After(series.StartTime) {
+		return domainErrors.Validation("INVALID_TIME_RANGE", "end time must be after start time",
+			domainErrors.FieldViolation{Field: "end_time", Description: "must be after start_time"})
+	}
+
+	if series.IntervalWeeks != 1 && series.IntervalWeeks != 2 {
+		return domainErrors.Validation("INVALID_INTERVAL", "series can repeat every week or every two weeks",
+			domainErrors.FieldViolation{Field: "interval_weeks", Description: "must be 1 or 2"})
+	}
+
+	if series.Count < 0 || series.Count > maxSeriesOccurrences {
+		message := fmt.Sprintf("count must be between 1 and %d", maxSeriesOccurrences)
+		return domainErrors.Validation("INVALID_COUNT", message,
+			domainErrors.FieldViolation{Field: "count", Description: message})
+	}
+
+	if series.Count == 0 && series.Until == nil {
+		return domainErrors.Validation("SERIES_UNBOUNDED", "either count or until_date is required",
+			domainErrors.FieldViolation{Field: "count", Description: "count or until_date is required"},
+			domainErrors.FieldViolation{Field: "until_date", Description: "count or until_date is required"})
+	}
+
+	if series.Until != nil && !series.Until.After(series.StartTime) {
+		return domainErrors.Validation("INVALID_UNTIL_DATE", "until date must not be before the first occurrence",
+			domainErrors.FieldViolation{Field: "until_date", Description: "must not be before start_time"})
+	}
+
+	return nil
+}
+
+// seriesOccurrences возвращает повторения серии (не больше maxSeriesOccurrences + 1,
+// чтобы можно было обнаружить слишком длинную серию). Повторения сдвигаются на целое
+// число недель по часам общежития, поэтому запись на 19:00 остается в 19:00
+// и после перехода на летнее время
+func seriesOccurrences(series bookingRepository.Series, location *time.Location) []Period {
+	duration := series.EndTime.Sub(series.StartTime)
+	first := series.StartTime.In(location)
+
+	var periods []Period
+	for i := 0; series.Count == 0 || i < series.Count; i++ {
+		start := first.AddDate(0, 0, 7*series.IntervalWeeks*i)
+		if series.Until != nil && !start.Before(*series.Until) {
+			break
+		}
+
+		periods = append(periods, Period{Start: start, End: start.Add(duration)})
+		if len(periods) > maxSeriesOccurrences {
+			break
+		}
+	}
+
+	return periods
+}
+
+// seriesNotCreatedError возвращает ошибку с причиной отказа для каждого повторения
+// (ключ - начало повторения в RFC 3339)
+func seriesNotCreatedError(occurrences []SeriesOccurrence) error {
+	err := domainErrors.Conflict("SERIES_NOT_CREATED", "none of the series occurrences could be booked")
+	for _, o := range occurrences {
+		if o.Err != nil {
+			err = err.WithMetadata(o.StartTime.UTC().Format(time.RFC3339), o.Err.Reason)
+		}
+	}
+	return err
+}
+
+// GetMySeries возвращает последние серии пользователя вместе с их записями
+func (s *Service) GetMySeries(ctx context.Context, userID int) ([]SeriesDetails, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	series, err := s.repo.GetUserSeries(ctx, conn.Conn(), userID, seriesHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SeriesDetails, len(series))
+	for i, sr := range series {
+		bookings, err := s.repo.GetBookings(ctx, conn.Conn(), bookingRepository.BookingFilter{SeriesID: sr.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bookings of series %d: %w", sr.ID, err)
+		}
+		result[i] = SeriesDetails{Series: sr, Bookings: bookings}
+	}
+
+	return result, nil
+}
+
+// CancelSeries отменяет записи серии. Для SeriesScopeOccurrence и SeriesScopeFollowing
+// bookingID - запись серии, с которой начинается отмена. Освободившееся время
+// предлагается листу ожидания. Возвращает количество удаленных записей
+func (s *Service) CancelSeries(ctx context.Context, seriesID, userID int, scope string, bookingID int) (int, error) {
+	var cancelled int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		series, err := s.repo.GetSeriesForUpdate(ctx, conn.Conn(), seriesID, userID)
+		if err != nil {
+			return err
+		}
+
+		var freed []bookingRepository.BookingDetails
+		switch scope {
+		case SeriesScopeOccurrence, SeriesScopeFollowing:
+			if bookingID == 0 {
+				return domainErrors.Validation("BOOKING_REQUIRED", "booking_id is required for this scope",
+					domainErrors.FieldViolation{Field: "booking_id", Description: "is required"})
+			}
+
+			booking, err := s.repo.GetBookingByID(ctx, conn.Conn(), bookingID)
+			if err != nil {
+				return err
+			}
+			if booking.SeriesID != series.ID {
+				return domainErrors.NotFound("BOOKING_NOT_IN_SERIES",
+					fmt.Sprintf("booking %d does not belong to series %d", bookingID, series.ID))
+			}
+
+			if scope == SeriesScopeOccurrence {
+				deleted, err := s.repo.DeleteBooking(ctx, conn.Conn(), bookingID, userID, "")
+				if err != nil {
+					return err
+				}
+				freed = append(freed, deleted)
+				break
+			}
+
+			freed, err = s.truncateSeries(ctx, conn, series.ID, booking.StartTime)
+			if err != nil {
+				return err
+			}
+		case SeriesScopeAll:
+			freed, err = s.truncateSeries(ctx, conn, series.ID, time.Now())
+			if err != nil {
+				return err
+			}
+		default:
+			return domainErrors.Validation("INVALID_SCOPE", "scope must be occurrence, following or all",
+				domainErrors.FieldViolation{Field: "scope", Description: "must be specified"})
+		}
+
+		for _, b := range freed {
+			metrics.BookingsDeleted.WithLabelValues(b.ResourceType, metrics.DeleteReasonCancelled).Inc()
+			if err := s.offerFreedTime(ctx, conn.Conn(), b); err != nil {
+				return err
+			}
+		}
+
+		cancelled = len(freed)
+		return nil
+	})
+
+	return cancelled, err
+}
+
+// truncateSeries удаляет записи серии, начинающиеся не раньше from, и завершает серию на from
+func (s *Service) truncateSeries(ctx context.Context, conn *pgxpool.Conn, seriesID int, from time.Time) ([]bookingRepository.BookingDetails, error) {
+	freed, err := s.repo.DeleteSeriesBookings(ctx, conn.Conn(), seriesID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.TruncateSeries(ctx, conn.Conn(), seriesID, from); err != nil {
+		return nil, err
+	}
+
+	return freed, nil
+}
@@ -0,0 +1,85 @@
+package bookingService
+
+import (
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"testing"
+	"time"
+)
+
+func TestSeriesOccurrencesAcrossDST(t *testing.T) {
+	berlin := loadBerlin(t)
+
+	until := func(value string) *time.Time {
+		parsed := mustParse(t, value)
+		return &parsed
+	}
+
+	tests := []struct {
+		name   string
+		series bookingRepository.Series
+		want   []string
+	}{
+		{
+			name: "spring forward",
+			series: bookingRepository.Series{
+				StartTime:     mustParse(t, "2026-03-21T19:00:00+01:00"),
+				EndTime:       mustParse(t, "2026-03-21T20:00:00+01:00"),
+				IntervalWeeks: 1,
+				Count:         3,
+			},
+			want: []string{"2026-03-21T19:00:00+01:00", "2026-03-28T19:00:00+01:00", "2026-04-04T19:00:00+02:00"},
+		},
+		{
+			name: "fall back",
+			series: bookingRepository.Series{
+				StartTime:     mustParse(t, "2026-10-17T19:00:00+02:00"),
+				EndTime:       mustParse(t, "2026-10-17T20:00:00+02:00"),
+				IntervalWeeks: 1,
+				Count:         3,
+			},
+			want: []string{"2026-10-17T19:00:00+02:00", "2026-10-24T19:00:00+02:00", "2026-10-31T19:00:00+01:00"},
+		},
+		{
+			name: "fall back, start given in UTC",
+			series: bookingRepository.Series{
+				StartTime:     mustParse(t, "2026-10-17T17:00:00Z"),
+				EndTime:       mustParse(t, "2026-10-17T18:00:00Z"),
+				IntervalWeeks: 1,
+				Count:         2,
+			},
+			want: []string{"2026-10-17T19:00:00+02:00", "2026-10-24T19:00:00+02:00"},
+		},
+		{
+			name: "fall back, every two weeks until",
+			series: bookingRepository.Series{
+				StartTime:     mustParse(t, "2026-10-10T19:00:00+02:00"),
+				EndTime:       mustParse(t, "2026-10-10T20:00:00+02:00"),
+				IntervalWeeks: 2,
+				Until:         until("2026-11-07T19:00:00+01:00"),
+			},
+			want: []string{"2026-10-10T19:00:00+02:00", "2026-10-24T19:00:00+02:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duration := tt.series.EndTime.Sub(tt.series.StartTime)
+
+			got := seriesOccurrences(tt.series, berlin)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i, p := range got {
+				if want := mustParse(t, tt.want[i]); !p.Start.Equal(want) {
+					t.Errorf("occurrence %d starts at %v, want %v", i, p.Start, want)
+				}
+				if local := p.Start.In(berlin); local.Hour() != 19 || local.Minute() != 0 {
+					t.Errorf("occurrence %d starts at %s local time, want 19:00", i, local.Format("15:04"))
+				}
+				if p.End.Sub(p.Start) != duration {
+					t.Errorf("occurrence %d lasts %v, want %v", i, p.End.Sub(p.Start), duration)
+				}
+			}
+		})
+	}
+}
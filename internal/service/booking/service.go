@@ -0,0 +1,402 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/metrics"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	utilsService "dormitory-helper-service/internal/service/utils"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BookingRepository interface {
+	GetResources(ctx context.Context, conn *pgx.Conn, resourceType string) ([]bookingRepository.Resource, error)
+	GetResourceByID(ctx context.Context, conn *pgx.Conn, resourceID int) (bookingRepository.Resource, error)
+	CreateBooking(ctx context.Context, conn *pgx.Conn, resource bookingRepository.Resource, userID int, startTime, endTime time.Time) (int, error)
+	GetBookings(ctx context.Context, conn *pgx.Conn, filter bookingRepository.BookingFilter) ([]bookingRepository.Booking, error)
+	DeleteBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int, resourceType string) (bookingRepository.BookingDetails, error)
+	GetActiveBan(ctx context.Context, conn *pgx.Conn, userID int) (*bookingRepository.Ban, error)
+	DeletePastBookings(ctx context.Context, conn *pgx.Conn, before time.Time) (int, error)
+	GetFacilityHours(ctx context.Context, conn *pgx.Conn, resourceType string) ([]bookingRepository.FacilityHours, error)
+	GetOverlappingBlackout(ctx context.Context, conn *pgx.Conn, resourceID int, startTime, endTime time.Time) (*bookingRepository.Blackout, error)
+	GetQuota(ctx context.Context, conn *pgx.Conn, resourceType string) (bookingRepository.Quota, error)
+	LockUser(ctx context.Context, conn *pgx.Conn, userID int) error
+	GetQuotaUsage(ctx context.Context, conn *pgx.Conn, userID int, resourceType string, now, dayStart, dayEnd, weekStart, weekEnd time.Time, excludeBookingID int) (bookingRepository.QuotaUsage, error)
+	HasUserBookingBetween(ctx context.Context, conn *pgx.Conn, userID int, resourceType string, startTime, endTime time.Time, excludeBookingID int) (bool, error)
+	GetBookingByID(ctx context.Context, conn *pgx.Conn, bookingID int) (bookingRepository.Booking, error)
+	UpdateBookingTime(ctx context.Context, conn *pgx.Conn, bookingID int, resource bookingRepository.Resource, startTime, endTime time.Time) error
+	CreateSeries(ctx context.Context, conn *pgx.Conn, series bookingRepository.Series) (int, error)
+	AttachBookingsToSeries(ctx context.Context, conn *pgx.Conn, seriesID int, bookingIDs []int) error
+	GetSeriesForUpdate(ctx context.Context, conn *pgx.Conn, seriesID, userID int) (bookingRepository.Series, error)
+	GetUserSeries(ctx context.Context, conn *pgx.Conn, userID int, limit int) ([]bookingRepository.Series, error)
+	TruncateSeries(ctx context.Context, conn *pgx.Conn, seriesID int, until time.Time) error
+	DeleteSeriesBookings(ctx context.Context, conn *pgx.Conn, seriesID int, from time.Time) ([]bookingRepository.BookingDetails, error)
+	GetResourceByCheckInToken(ctx context.Context, conn *pgx.Conn, token string) (bookingRepository.Resource, error)
+	CheckIn(ctx context.Context, conn *pgx.Conn, bookingID int, at time.Time) error
+	CheckOut(ctx context.Context, conn *pgx.Conn, bookingID int, at time.Time) error
+	ReleaseNoShows(ctx context.Context, conn *pgx.Conn, now time.Time) ([]bookingRepository.BookingDetails, error)
+	CountNoShows(ctx context.Context, conn *pgx.Conn, userID int, since time.Time) (int, error)
+	CreateBan(ctx context.Context, conn *pgx.Conn, ban bookingRepository.Ban) error
+	GetResourceForShare(ctx context.Context, conn *pgx.Conn, resourceID int) (bookingRepository.Resource, error)
+	IsOutOfOrder(ctx context.Context, conn *pgx.Conn, resourceID int) (bool, error)
+	GetBlackouts(ctx context.Context, conn *pgx.Conn, filter bookingRepository.BlackoutFilter) ([]bookingRepository.Blackout, error)
+}
+
+type Service struct {
+	repo     BookingRepository
+	waitlist WaitlistRepository
+	db       *pgxpool.Pool
+	// maxAdvance насколько далеко вперед можно записаться. 0 - без ограничения
+	maxAdvance time.Duration
+	// location часовой пояс общежития, в котором проверяются правила записи
+	location *time.Location
+	// waitlistHold сколько удерживается запись, выданная из листа ожидания
+	waitlistHold time.Duration
+	// noShow ограничение на бронирование за неявки
+	noShow NoShowPolicy
+	// slotGrid шаг поиска свободных слотов на ресурсах без шага слота
+	slotGrid time.Duration
+}
+
+func NewService(repo BookingRepository, waitlist WaitlistRepository, db *pgxpool.Pool, maxAdvance time.Duration, location *time.Location, waitlistHold time.Duration, noShow NoShowPolicy, slotGrid time.Duration) *Service {
+	return &Service{
+		repo:         repo,
+		waitlist:     waitlist,
+		db:           db,
+		maxAdvance:   maxAdvance,
+		location:     location,
+		waitlistHold: waitlistHold,
+		noShow:       noShow,
+		slotGrid:     slotGrid,
+	}
+}
+
+// Location возвращает часовой пояс общежития
+func (s *Service) Location() *time.Location {
+	return s.location
+}
+
+// DayRange возвращает начало дня date (YYYY-MM-DD) и начало следующего дня
+// в часовом поясе общежития. В дни перехода на летнее время и обратно
+// день длится 23 или 25 часов
+func (s *Service) DayRange(date string) (time.Time, time.Time, error) {
+	day, err := time.ParseInLocation(time.DateOnly, date, s.location)
+	if err != nil {
+		return time.Time{}, time.Time{}, domainErrors.Validation("INVALID_DATE", "date must be in YYYY-MM-DD format",
+			domainErrors.FieldViolation{Field: "date", Description: "must be in YYYY-MM-DD format"})
+	}
+
+	dayStart, dayEnd := s.dayBounds(day)
+	return dayStart, dayEnd, nil
+}
+
+// ListResources возвращает активные ресурсы заданного типа (или всех типов)
+func (s *Service) ListResources(ctx context.Context, resourceType string) ([]bookingRepository.Resource, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	resources, err := s.repo.GetResources(ctx, conn.Conn(), resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+// ResolveResource возвращает единственный ресурс заданного типа.
+// Используется адаптерами помещений, у которых пока один бронируемый ресурс
+func (s *Service) ResolveResource(ctx context.Context, resourceType string) (bookingRepository.Resource, error) {
+	resources, err := s.ListResources(ctx, resourceType)
+	if err != nil {
+		return bookingRepository.Resource{}, err
+	}
+	if len(resources) == 0 {
+		return bookingRepository.Resource{}, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("no active %s resource configured", resourceType))
+	}
+	return resources[0], nil
+}
+
+// CreateBooking создает запись на ресурс, проверяя правила ресурса. Если время занято
+// или ресурс закрыт, к ошибке добавляются ближайшие свободные слоты той же длительности
+func (s *Service) CreateBooking(ctx context.Context, userID, resourceID int, startTime, endTime time.Time) (int, error) {
+	bookingID, err := s.TryCreateBooking(ctx, userID, resourceID, startTime, endTime)
+	if err != nil {
+		return 0, s.withAlternatives(ctx, err, []int{resourceID}, startTime, endTime)
+	}
+
+	return bookingID, nil
+}
+
+// TryCreateBooking создает запись как CreateBooking, но без поиска альтернатив.
+// Используется при переборе нескольких ресурсов: альтернативы ищутся один раз
+// по всем ресурсам через SuggestAlternatives
+func (s *Service) TryCreateBooking(ctx context.Context, userID, resourceID int, startTime, endTime time.Time) (int, error) {
+	if endTime.Before(startTime) || endTime.Equal(startTime) {
+		return 0, domainErrors.Validation("INVALID_TIME_RANGE", "end time must be after start time",
+			domainErrors.FieldViolation{Field: "end_time", Description: "must be after start_time"})
+	}
+
+	var bookingID int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		resource, err := s.repo.GetResourceByID(ctx, conn.Conn(), resourceID)
+		if err != nil {
+			return err
+		}
+
+		bookingID, err = s.createBooking(ctx, conn.Conn(), userID, resource, startTime, endTime)
+		return err
+	})
+
+	return bookingID, err
+}
+
+// createBooking проверяет все правила и создает запись в текущей транзакции.
+// Используется при создании записи пользователем и при выдаче записи из листа ожидания
+func (s *Service) createBooking(ctx context.Context, conn *pgx.Conn, userID int, resource bookingRepository.Resource, startTime, endTime time.Time) (int, error) {
+	if err := s.checkBookingRules(ctx, conn, userID, resource, startTime, endTime, 0); err != nil {
+		return 0, err
+	}
+
+	bookingID, err := s.repo.CreateBooking(ctx, conn, resource, userID, startTime, endTime)
+	if err != nil {
+		if errors.Is(err, bookingRepository.ErrTimeSlotBooked) {
+			metrics.BookingConflicts.WithLabelValues(resource.Type, strconv.Itoa(resource.ID)).Inc()
+		}
+		return 0, err
+	}
+
+	metrics.BookingsCreated.WithLabelValues(resource.Type, strconv.Itoa(resource.ID)).Inc()
+	return bookingID, nil
+}
+
+// checkBookingRules проверяет правила записи пользователя на ресурс: запрет на бронирование,
+// длительность и выравнивание, часы работы, закрытия и лимиты. excludeBookingID - изменяемая
+// запись, которая не учитывается в лимитах (0 для новой записи). Блокирует пользователя
+// до конца транзакции
+func (s *Service) checkBookingRules(ctx context.Context, conn *pgx.Conn, userID int, resource bookingRepository.Resource, startTime, endTime time.Time, excludeBookingID int) error {
+	if err := s.checkNotBanned(ctx, conn, userID); err != nil {
+		return err
+	}
+
+	if err := s.repo.LockUser(ctx, conn, userID); err != nil {
+		return err
+	}
+
+	if err := validateBooking(resource, startTime, endTime, s.location); err != nil {
+		return err
+	}
+
+	if err := s.checkInService(ctx, conn, resource); err != nil {
+		return err
+	}
+
+	hours, err := s.repo.GetFacilityHours(ctx, conn, resource.Type)
+	if err != nil {
+		return err
+	}
+
+	if err := checkFacilityHours(resource, hours, startTime, endTime, s.location); err != nil {
+		return err
+	}
+
+	if err := s.checkNoBlackout(ctx, conn, resource, startTime, endTime); err != nil {
+		return err
+	}
+
+	return s.checkQuota(ctx, conn, userID, resource, startTime, endTime, excludeBookingID)
+}
+
+// tryCreateBooking создает запись внутри точки сохранения, чтобы неудачная попытка
+// (для одного ожидающего или одного повторения серии) не прерывала всю транзакцию
+func (s *Service) tryCreateBooking(ctx context.Context, conn *pgx.Conn, userID int, resource bookingRepository.Resource, startTime, endTime time.Time) (int, error) {
+	if _, err := conn.Exec(ctx, `SAVEPOINT try_create_booking`); err != nil {
+		return 0, fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	bookingID, err := s.createBooking(ctx, conn, userID, resource, startTime, endTime)
+	if err != nil {
+		if _, rollbackErr := conn.Exec(ctx, `ROLLBACK TO SAVEPOINT try_create_booking`); rollbackErr != nil {
+			return 0, fmt.Errorf("failed to roll back to savepoint: %w", rollbackErr)
+		}
+		return 0, err
+	}
+
+	if _, err := conn.Exec(ctx, `RELEASE SAVEPOINT try_create_booking`); err != nil {
+		return 0, fmt.Errorf("failed to release savepoint: %w", err)
+	}
+
+	return bookingID, nil
+}
+
+// checkNotBanned возвращает ошибку, если администратор запретил пользователю бронирование
+func (s *Service) checkNotBanned(ctx context.Context, conn *pgx.Conn, userID int) error {
+	ban, err := s.repo.GetActiveBan(ctx, conn, userID)
+	if err != nil {
+		return err
+	}
+	if ban == nil {
+		return nil
+	}
+
+	banErr := domainErrors.Forbidden("USER_BANNED", "user is banned from booking")
+	if ban.Reason != "" {
+		banErr = banErr.WithMetadata("reason", ban.Reason)
+	}
+	if ban.BannedUntil != nil {
+		banErr = banErr.WithMetadata("banned_until", ban.BannedUntil.UTC().Format(time.RFC3339))
+	}
+	return banErr
+}
+
+// checkInService возвращает ошибку, если ресурс неисправен или отключен. Проверяется
+// под блокировкой строк ресурса, чтобы отключение или поломка, отмеченные одновременно
+// с записью, не пропустили ее
+func (s *Service) checkInService(ctx context.Context, conn *pgx.Conn, resource bookingRepository.Resource) error {
+	current, err := s.repo.GetResourceForShare(ctx, conn, resource.ID)
+	if err != nil {
+		return err
+	}
+	if !current.IsActive {
+		return domainErrors.Conflict("RESOURCE_UNAVAILABLE", fmt.Sprintf("%s is not available for booking", resource.Name))
+	}
+
+	outOfOrder, err := s.repo.IsOutOfOrder(ctx, conn, resource.ID)
+	if err != nil {
+		return err
+	}
+	if outOfOrder {
+		return domainErrors.Conflict(bookingRepository.ReasonOutOfOrder, fmt.Sprintf("%s is out of order", resource.Name))
+	}
+
+	return nil
+}
+
+// checkNoBlackout возвращает ошибку, если ресурс закрыт администратором на выбранное время
+func (s *Service) checkNoBlackout(ctx context.Context, conn *pgx.Conn, resource bookingRepository.Resource, startTime, endTime time.Time) error {
+	blackout, err := s.repo.GetOverlappingBlackout(ctx, conn, resource.ID, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	if blackout == nil {
+		return nil
+	}
+
+	blackoutErr := domainErrors.Conflict(bookingRepository.ReasonBlackedOut, fmt.Sprintf("%s is closed for the selected time", resource.Name)).
+		WithMetadata("blackout_start", blackout.StartTime.UTC().Format(time.RFC3339)).
+		WithMetadata("blackout_end", blackout.EndTime.UTC().Format(time.RFC3339))
+	if blackout.Reason != "" {
+		blackoutErr = blackoutErr.WithMetadata("reason", blackout.Reason)
+	}
+	return blackoutErr
+}
+
+// validateBooking проверяет длительность и выравнивание записи по правилам ресурса.
+// Выравнивание проверяется по местному времени общежития
+func validateBooking(resource bookingRepository.Resource, startTime, endTime time.Time, location *time.Location) error {
+	if !resource.IsActive {
+		return domainErrors.Conflict("RESOURCE_UNAVAILABLE", fmt.Sprintf("%s is not available for booking", resource.Name))
+	}
+
+	duration := endTime.Sub(startTime)
+	if duration > time.Duration(resource.MaxDurationMinutes)*time.Minute {
+		message := fmt.Sprintf("%s booking duration cannot exceed %s", resource.Type, formatMinutes(resource.MaxDurationMinutes))
+		return domainErrors.Validation("DURATION_TOO_LONG", message,
+			domainErrors.FieldViolation{Field: "end_time", Description: message})
+	}
+
+	if minDuration := resource.Rules.MinDurationMinutes; minDuration > 0 && duration < time.Duration(minDuration)*time.Minute {
+		message := fmt.Sprintf("%s booking duration must be at least %s", resource.Type, formatMinutes(minDuration))
+		return domainErrors.Validation("DURATION_TOO_SHORT", message,
+			domainErrors.FieldViolation{Field: "end_time", Description: message})
+	}
+
+	if step := resource.Rules.SlotStepMinutes; step > 0 {
+		if !alignedToStep(startTime, step, location) || !alignedToStep(endTime, step, location) {
+			message := fmt.Sprintf("%s booking must be aligned to %s slots", resource.Type, formatMinutes(resource.Rules.SlotStepMinutes))
+			return domainErrors.Validation("SLOT_NOT_ALIGNED", message,
+				domainErrors.FieldViolation{Field: "start_time", Description: message},
+				domainErrors.FieldViolation{Field: "end_time", Description: message})
+		}
+	}
+
+	return nil
+}
+
+// alignedToStep проверяет, что время по местным часам кратно шагу слота от полуночи
+// (10:00, 10:30 для шага 30 минут). Считается по показаниям часов, поэтому
+// сетка слотов не сдвигается ни в дни перехода на летнее время, ни в часовых
+// поясах со смещением, не кратным часу
+func alignedToStep(t time.Time, stepMinutes int, location *time.Location) bool {
+	local := t.In(location)
+	if local.Second() != 0 || local.Nanosecond() != 0 {
+		return false
+	}
+	return (local.Hour()*60+local.Minute())%stepMinutes == 0
+}
+
+// formatMinutes форматирует длительность для сообщений об ошибках ("2 hours", "90 minutes")
+func formatMinutes(minutes int) string {
+	switch {
+	case minutes == 60:
+		return "1 hour"
+	case minutes%60 == 0:
+		return fmt.Sprintf("%d hours", minutes/60)
+	default:
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+}
+
+// GetBookings получает записи на ресурсы, удовлетворяющие фильтру
+func (s *Service) GetBookings(ctx context.Context, filter bookingRepository.BookingFilter) ([]bookingRepository.Booking, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	bookings, err := s.repo.GetBookings(ctx, conn.Conn(), filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookings: %w", err)
+	}
+
+	return bookings, nil
+}
+
+// DeleteBooking удаляет запись пользователя. Если resourceType не пустой,
+// удаляется только запись на ресурс этого типа. Освободившееся время
+// в той же транзакции предлагается первому подходящему из листа ожидания
+func (s *Service) DeleteBooking(ctx context.Context, bookingID, userID int, resourceType string) error {
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		deleted, err := s.repo.DeleteBooking(ctx, conn.Conn(), bookingID, userID, resourceType)
+		if err != nil {
+			return err
+		}
+
+		metrics.BookingsDeleted.WithLabelValues(deleted.ResourceType, metrics.DeleteReasonCancelled).Inc()
+		return s.offerFreedTime(ctx, conn.Conn(), deleted)
+	})
+}
+
+// PurgePastBookings удаляет записи, закончившиеся более retention назад.
+// Возвращает количество удаленных записей
+func (s *Service) PurgePastBookings(ctx context.Context, retention time.Duration) (int, error) {
+	var deleted int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		var err error
+		deleted, err = s.repo.DeletePastBookings(ctx, conn.Conn(), time.Now().Add(-retention))
+		return err
+	})
+	if err == nil {
+		metrics.BookingsPurged.Add(float64(deleted))
+	}
+	return deleted, err
+}
@@ -0,0 +1,62 @@
+package bookingService
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+// В 2026 году в Берлине часы переводятся вперед 29 марта (02:00 -> 03:00)
+// и назад 25 октября (03:00 -> 02:00)
+
+// loadBerlin загружает часовой пояс Europe/Berlin
+func loadBerlin(t *testing.T) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load Europe/Berlin: %v", err)
+	}
+	return location
+}
+
+// mustParse разбирает время в RFC 3339
+func mustParse(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", value, err)
+	}
+	return parsed
+}
+
+func TestAlignedToStepAcrossDST(t *testing.T) {
+	berlin := loadBerlin(t)
+
+	tests := []struct {
+		name        string
+		at          string
+		stepMinutes int
+		want        bool
+	}{
+		{name: "spring forward, first hour after the gap", at: "2026-03-29T03:00:00+02:00", stepMinutes: 60, want: true},
+		{name: "spring forward, half hour", at: "2026-03-29T03:30:00+02:00", stepMinutes: 30, want: true},
+		{name: "spring forward, quarter past", at: "2026-03-29T03:15:00+02:00", stepMinutes: 30, want: false},
+		{name: "spring forward, moment given in UTC", at: "2026-03-29T01:00:00Z", stepMinutes: 60, want: true},
+		{name: "fall back, first 02:00", at: "2026-10-25T02:00:00+02:00", stepMinutes: 60, want: true},
+		{name: "fall back, repeated 02:00", at: "2026-10-25T02:00:00+01:00", stepMinutes: 60, want: true},
+		{name: "fall back, repeated 02:30 on hourly grid", at: "2026-10-25T02:30:00+01:00", stepMinutes: 60, want: false},
+		{name: "fall back, repeated 02:30 on half-hour grid", at: "2026-10-25T02:30:00+01:00", stepMinutes: 30, want: true},
+		{name: "fall back, 90-minute grid counts from local midnight", at: "2026-10-25T03:00:00+01:00", stepMinutes: 90, want: true},
+		{name: "seconds are not aligned", at: "2026-10-25T03:00:30+01:00", stepMinutes: 30, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := alignedToStep(mustParse(t, tt.at), tt.stepMinutes, berlin); got != tt.want {
+				t.Errorf("alignedToStep(%s, %d) = %v, want %v", tt.at, tt.stepMinutes, got, tt.want)
+			}
+		})
+	}
+}
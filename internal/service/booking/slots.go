@@ -0,0 +1,418 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// defaultSlotLimit и maxSlotLimit сколько свободных слотов возвращается за один запрос
+	defaultSlotLimit = 50
+	maxSlotLimit     = 200
+	// maxSlotSearchRange в каком промежутке можно искать свободные слоты за один запрос
+	maxSlotSearchRange = 14 * 24 * time.Hour
+	// alternativesWindow насколько раньше и позже занятого времени ищутся альтернативы
+	alternativesWindow = 24 * time.Hour
+	// alternativesCount сколько альтернатив предлагается к ошибке занятого времени
+	alternativesCount = 3
+)
+
+// Slot свободное время на ресурсе
+type Slot struct {
+	ResourceID int
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// SlotQuery условия поиска свободных слотов
+type SlotQuery struct {
+	ResourceType string
+	// ResourceIDs ресурсы, среди которых ищутся слоты. Пустой - все активные ресурсы типа
+	ResourceIDs []int
+	// From и To промежуток, в котором должны начинаться слоты
+	From     time.Time
+	To       time.Time
+	Duration time.Duration
+	// Limit сколько слотов вернуть. 0 - defaultSlotLimit
+	Limit int
+}
+
+// FindAvailableSlots возвращает свободные слоты длительностью query.Duration в порядке
+// начала. Начала слотов лежат на сетке шага слота ресурса (или шага по умолчанию)
+// по местным часам. Слоты не пересекают записи, закрытия ресурса, тихие часы и время
+// вне часов работы, не начинаются в прошлом и позже, чем можно записаться заранее.
+// Лимиты пользователя не учитываются. Слоты на одном ресурсе могут пересекаться
+func (s *Service) FindAvailableSlots(ctx context.Context, query SlotQuery) ([]Slot, error) {
+	if err := validateSlotQuery(&query); err != nil {
+		return nil, err
+	}
+
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	resources, err := s.slotResources(ctx, conn.Conn(), query)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := s.repo.GetQuota(ctx, conn.Conn(), query.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from, to := query.From, query.To
+	if from.Before(now) {
+		from = now
+	}
+	if maxAdvance := s.maxAdvanceFor(quota); maxAdvance > 0 && to.After(now.Add(maxAdvance)) {
+		to = now.Add(maxAdvance)
+	}
+	if !to.After(from) {
+		return nil, nil
+	}
+
+	return s.findSlots(ctx, conn.Conn(), resources, from, to, query.Duration, query.Limit)
+}
+
+// FindNextAvailableSlot возвращает ближайший свободный слот начиная с текущего момента.
+// query.From, query.To и query.Limit не используются
+func (s *Service) FindNextAvailableSlot(ctx context.Context, query SlotQuery) (Slot, error) {
+	query.From = time.Now()
+	query.To = query.From.Add(maxSlotSearchRange)
+	query.Limit = 1
+
+	slots, err := s.FindAvailableSlots(ctx, query)
+	if err != nil {
+		return Slot{}, err
+	}
+	if len(slots) == 0 {
+		return Slot{}, domainErrors.NotFound("NO_FREE_SLOT",
+			fmt.Sprintf("no free %s slot in the next %d days", query.ResourceType, int(maxSlotSearchRange/(24*time.Hour))))
+	}
+
+	return slots[0], nil
+}
+
+// DateRange возвращает промежуток от начала дня fromDate до конца дня toDate
+// включительно (YYYY-MM-DD, по времени общежития). По умолчанию fromDate - сегодня,
+// toDate - fromDate
+func (s *Service) DateRange(fromDate, toDate string) (time.Time, time.Time, error) {
+	var from, to time.Time
+	if fromDate == "" {
+		from, to = s.dayBounds(time.Now())
+	} else {
+		var err error
+		from, to, err = s.DayRange(fromDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if toDate != "" {
+		_, end, err := s.DayRange(toDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, domainErrors.Validation("INVALID_DATE", "to_date must be in YYYY-MM-DD format",
+				domainErrors.FieldViolation{Field: "to_date", Description: "must be in YYYY-MM-DD format"})
+		}
+		to = end
+	}
+
+	return from, to, nil
+}
+
+// validateSlotQuery проверяет условия поиска и подставляет лимит по умолчанию
+func validateSlotQuery(query *SlotQuery) error {
+	if query.ResourceType == "" {
+		return domainErrors.Validation("RESOURCE_TYPE_REQUIRED", "resource type is required",
+			domainErrors.FieldViolation{Field: "resource_type", Description: "is required"})
+	}
+
+	if query.Duration <= 0 || query.Duration%time.Minute != 0 {
+		return domainErrors.Validation("INVALID_DURATION", "duration must be a positive number of minutes",
+			domainErrors.FieldViolation{Field: "duration_minutes", Description: "must be positive"})
+	}
+
+	if !query.To.After(query.From) {
+		return domainErrors.Validation("INVALID_DATE_RANGE", "end of the range must be after its start",
+			domainErrors.FieldViolation{Field: "to_date", Description: "must not be before from_date"})
+	}
+	if query.To.Sub(query.From) > maxSlotSearchRange {
+		message := fmt.Sprintf("slots can be searched at most %d days at a time", int(maxSlotSearchRange/(24*time.Hour)))
+		return domainErrors.Validation("DATE_RANGE_TOO_LONG", message,
+			domainErrors.FieldViolation{Field: "to_date", Description: message})
+	}
+
+	if query.Limit == 0 {
+		query.Limit = defaultSlotLimit
+	}
+	if query.Limit < 0 || query.Limit > maxSlotLimit {
+		message := fmt.Sprintf("limit must be between 1 and %d", maxSlotLimit)
+		return domainErrors.Validation("INVALID_LIMIT", message,
+			domainErrors.FieldViolation{Field: "limit", Description: message})
+	}
+
+	return nil
+}
+
+// slotResources возвращает ресурсы, на которых можно искать слоты длительностью
+// query.Duration. Если ни один ресурс не подходит, возвращается причина для первого
+func (s *Service) slotResources(ctx context.Context, conn *pgx.Conn, query SlotQuery) ([]bookingRepository.Resource, error) {
+	all, err := s.repo.GetResources(ctx, conn, query.ResourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	candidates := all
+	if len(query.ResourceIDs) > 0 {
+		candidates = nil
+		for _, id := range query.ResourceIDs {
+			i := slices.IndexFunc(all, func(r bookingRepository.Resource) bool { return r.ID == id })
+			if i < 0 {
+				return nil, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("%s resource %d not found", query.ResourceType, id))
+			}
+			candidates = append(candidates, all[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("no active %s resource configured", query.ResourceType))
+	}
+
+	// Длительность проверяется так же, как при записи, на слоте от полуночи,
+	// который выровнен по любому шагу
+	dayStart, _ := s.dayBounds(query.From)
+
+	var resources []bookingRepository.Resource
+	var firstErr error
+	for _, r := range candidates {
+		if err := validateBooking(r, dayStart, dayStart.Add(query.Duration), s.location); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		resources = append(resources, r)
+	}
+	if len(resources) == 0 {
+		return nil, firstErr
+	}
+
+	return resources, nil
+}
+
+// findSlots ищет на ресурсах свободные слоты длительностью duration, начинающиеся
+// в [from, to). limit 0 - без ограничения
+func (s *Service) findSlots(ctx context.Context, conn *pgx.Conn, resources []bookingRepository.Resource, from, to time.Time, duration time.Duration, limit int) ([]Slot, error) {
+	windowEnd := to.Add(duration)
+	hoursByType := make(map[string][]bookingRepository.FacilityHours)
+
+	var slots []Slot
+	for _, resource := range resources {
+		hours, ok := hoursByType[resource.Type]
+		if !ok {
+			var err error
+			hours, err = s.repo.GetFacilityHours(ctx, conn, resource.Type)
+			if err != nil {
+				return nil, err
+			}
+			hoursByType[resource.Type] = hours
+		}
+
+		bookings, err := s.repo.GetBookings(ctx, conn, bookingRepository.BookingFilter{
+			ResourceID:  resource.ID,
+			StartBefore: &windowEnd,
+			EndAfter:    &from,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bookings of resource %d: %w", resource.ID, err)
+		}
+
+		blackouts, err := s.repo.GetBlackouts(ctx, conn, bookingRepository.BlackoutFilter{
+			ResourceID: resource.ID,
+			StartTime:  &from,
+			EndTime:    &windowEnd,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		open, quiet := splitHours(hours)
+		busy := closedPeriods(open, quiet, from, windowEnd, s.location)
+		for _, b := range bookings {
+			busy = append(busy, Period{Start: b.StartTime, End: b.EndTime})
+		}
+		for _, b := range blackouts {
+			busy = append(busy, Period{Start: b.StartTime, End: b.EndTime})
+		}
+
+		slots = append(slots, s.freeSlots(resource, mergePeriods(busy), from, to, duration, limit)...)
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		return slots[i].StartTime.Before(slots[j].StartTime)
+	})
+	if limit > 0 && len(slots) > limit {
+		slots = slots[:limit]
+	}
+
+	return slots, nil
+}
+
+// freeSlots перебирает начала слотов на сетке ресурса в [from, to) и возвращает те,
+// что не пересекают занятые периоды busy (отсортированные и объединенные)
+func (s *Service) freeSlots(resource bookingRepository.Resource, busy []Period, from, to time.Time, duration time.Duration, limit int) []Slot {
+	step := resource.Rules.SlotStepMinutes
+	if step == 0 {
+		step = int(s.slotGrid / time.Minute)
+	}
+
+	var slots []Slot
+	next := 0
+	for start := ceilToStep(from, step, s.location); start.Before(to); {
+		end := start.Add(duration)
+
+		for next < len(busy) && !busy[next].End.After(start) {
+			next++
+		}
+		if next < len(busy) && busy[next].Start.Before(end) {
+			start = ceilToStep(busy[next].End, step, s.location)
+			continue
+		}
+
+		// В дни перехода на летнее время конец слота может оказаться не на сетке
+		if validateBooking(resource, start, end, s.location) == nil {
+			slots = append(slots, Slot{ResourceID: resource.ID, StartTime: start, EndTime: end})
+			if limit > 0 && len(slots) == limit {
+				break
+			}
+		}
+
+		start = ceilToStep(start.Add(time.Minute), step, s.location)
+	}
+
+	return slots
+}
+
+// ceilToStep возвращает ближайший к t не раньше него момент, когда местные часы
+// кратны шагу от полуночи (см. alignedToStep). Шаг прибавляется к абсолютному
+// времени, поэтому в повторяющийся час после перехода на зимнее время
+// перебор не возвращается назад
+func ceilToStep(t time.Time, stepMinutes int, location *time.Location) time.Time {
+	if alignedToStep(t, stepMinutes, location) {
+		return t
+	}
+
+	local := t.In(location)
+	minutes := local.Hour()*60 + local.Minute()
+	return local.Truncate(time.Minute).Add(time.Duration(stepMinutes-minutes%stepMinutes) * time.Minute)
+}
+
+// withAlternatives добавляет ближайшие свободные слоты к ошибке записи на занятое
+// или закрытое время. Остальные ошибки возвращаются без изменений
+func (s *Service) withAlternatives(ctx context.Context, err error, resourceIDs []int, startTime, endTime time.Time) error {
+	domainErr, ok := domainErrors.As(err)
+	if !ok || (domainErr.Reason != bookingRepository.ErrTimeSlotBooked.Reason && domainErr.Reason != bookingRepository.ReasonBlackedOut) {
+		return err
+	}
+	return s.SuggestAlternatives(ctx, domainErr, resourceIDs, startTime, endTime)
+}
+
+// SuggestAlternatives добавляет к ошибке до alternativesCount ближайших к startTime
+// свободных слотов той же длительности на ресурсах resourceIDs: в метаданных
+// alternative_N - промежуток "начало/конец" в RFC 3339, alternative_N_resource_id - ресурс.
+// Альтернативы необязательны, поэтому при сбое поиска ошибка возвращается как есть
+func (s *Service) SuggestAlternatives(ctx context.Context, conflict *domainErrors.Error, resourceIDs []int, startTime, endTime time.Time) *domainErrors.Error {
+	slots, err := s.nearestSlots(ctx, resourceIDs, startTime, endTime.Sub(startTime))
+	if err != nil {
+		slog.WarnContext(ctx, "failed to find alternative slots", slog.Any("error", err))
+		return conflict
+	}
+
+	for i, slot := range slots {
+		key := "alternative_" + strconv.Itoa(i+1)
+		conflict = conflict.WithMetadata(key, slot.StartTime.UTC().Format(time.RFC3339)+"/"+slot.EndTime.UTC().Format(time.RFC3339)).
+			WithMetadata(key+"_resource_id", strconv.Itoa(slot.ResourceID))
+	}
+	return conflict
+}
+
+// nearestSlots возвращает до alternativesCount свободных слотов, ближайших к startTime
+// (в пределах alternativesWindow), в порядке начала
+func (s *Service) nearestSlots(ctx context.Context, resourceIDs []int, startTime time.Time, duration time.Duration) ([]Slot, error) {
+	if duration <= 0 {
+		return nil, nil
+	}
+
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var resources []bookingRepository.Resource
+	for _, id := range resourceIDs {
+		resource, err := s.repo.GetResourceByID(ctx, conn.Conn(), id)
+		if err != nil {
+			return nil, err
+		}
+		if resource.IsActive {
+			resources = append(resources, resource)
+		}
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+
+	quota, err := s.repo.GetQuota(ctx, conn.Conn(), resources[0].Type)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from, to := startTime.Add(-alternativesWindow), startTime.Add(alternativesWindow)
+	if from.Before(now) {
+		from = now
+	}
+	if maxAdvance := s.maxAdvanceFor(quota); maxAdvance > 0 && to.After(now.Add(maxAdvance)) {
+		to = now.Add(maxAdvance)
+	}
+	if !to.After(from) {
+		return nil, nil
+	}
+
+	slots, err := s.findSlots(ctx, conn.Conn(), resources, from, to, duration, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := func(slot Slot) time.Duration {
+		d := slot.StartTime.Sub(startTime)
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+	sort.SliceStable(slots, func(i, j int) bool {
+		return distance(slots[i]) < distance(slots[j])
+	})
+	if len(slots) > alternativesCount {
+		slots = slots[:alternativesCount]
+	}
+	sort.SliceStable(slots, func(i, j int) bool {
+		return slots[i].StartTime.Before(slots[j].StartTime)
+	})
+
+	return slots, nil
+}
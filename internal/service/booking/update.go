@@ -0,0 +1,197 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/metrics"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	utilsService "dormitory-helper-service/internal/service/utils"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BookingChange изменение записи пользователем. Незаполненные поля не меняются
+type BookingChange struct {
+	// ResourceID другой ресурс того же типа. 0 - тот же ресурс
+	ResourceID int
+	StartTime  *time.Time
+	EndTime    *time.Time
+	// ExtendMinutes на сколько продлить запись (после применения EndTime)
+	ExtendMinutes int
+}
+
+// UpdateBooking переносит запись пользователя и/или меняет ее длительность.
+// Новое время проверяется по тем же правилам, что и новая запись, а пересечения -
+// без учета самой записи, поэтому между удалением и созданием время никто не займет.
+// У начавшейся записи можно менять только время окончания. Если resourceType не пустой,
+// меняется только запись на ресурс этого типа. Освободившееся время предлагается
+// листу ожидания. Возвращает измененную запись
+func (s *Service) UpdateBooking(ctx context.Context, bookingID, userID int, resourceType string, change BookingChange) (bookingRepository.Booking, error) {
+	var updated bookingRepository.Booking
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		// Изменения записей одного пользователя выполняются последовательно,
+		// поэтому запись не изменится между чтением и обновлением
+		if err := s.repo.LockUser(ctx, conn.Conn(), userID); err != nil {
+			return err
+		}
+
+		booking, err := s.repo.GetBookingByID(ctx, conn.Conn(), bookingID)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.repo.GetResourceByID(ctx, conn.Conn(), booking.ResourceID)
+		if err != nil {
+			return err
+		}
+		if resourceType != "" && current.Type != resourceType {
+			return domainErrors.NotFound("BOOKING_NOT_FOUND", fmt.Sprintf("booking %d not found", bookingID))
+		}
+		if booking.UserID != userID {
+			return domainErrors.Forbidden("NOT_BOOKING_OWNER", "user is not the owner of the booking")
+		}
+
+		resource := current
+		if change.ResourceID != 0 && change.ResourceID != current.ID {
+			resource, err = s.repo.GetResourceByID(ctx, conn.Conn(), change.ResourceID)
+			if err != nil {
+				return err
+			}
+			if resource.Type != current.Type {
+				return domainErrors.Validation("RESOURCE_TYPE_MISMATCH",
+					fmt.Sprintf("resource %d is not a %s resource", resource.ID, current.Type),
+					domainErrors.FieldViolation{Field: "resource_id", Description: "must be a resource of the same type"})
+			}
+		}
+
+		startTime, endTime := booking.StartTime, booking.EndTime
+		if change.StartTime != nil {
+			startTime = *change.StartTime
+		}
+		if change.EndTime != nil {
+			endTime = *change.EndTime
+		}
+		endTime = endTime.Add(time.Duration(change.ExtendMinutes) * time.Minute)
+
+		if err := checkChangeAllowed(booking, resource, startTime, endTime, time.Now()); err != nil {
+			return err
+		}
+
+		if err := s.checkBookingRules(ctx, conn.Conn(), userID, resource, startTime, endTime, booking.ID); err != nil {
+			return err
+		}
+
+		if err := s.repo.UpdateBookingTime(ctx, conn.Conn(), booking.ID, resource, startTime, endTime); err != nil {
+			if errors.Is(err, bookingRepository.ErrTimeSlotBooked) {
+				metrics.BookingConflicts.WithLabelValues(resource.Type, strconv.Itoa(resource.ID)).Inc()
+			}
+			return err
+		}
+
+		updated = booking
+		updated.ResourceID = resource.ID
+		updated.StartTime = startTime
+		updated.EndTime = endTime
+		metrics.BookingsUpdated.WithLabelValues(resource.Type).Inc()
+
+		return s.offerVacatedTime(ctx, conn.Conn(), current, booking, updated)
+	})
+
+	return updated, err
+}
+
+// OfferVacatedTime предлагает листу ожидания время, освободившееся после переноса
+// записи before в after. Вызывается в транзакции переноса
+func (s *Service) OfferVacatedTime(ctx context.Context, conn *pgx.Conn, before, after bookingRepository.Booking) error {
+	resource, err := s.repo.GetResourceByID(ctx, conn, before.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	return s.offerVacatedTime(ctx, conn, resource, before, after)
+}
+
+// offerVacatedTime предлагает листу ожидания части времени записи before на ресурсе
+// resource, которые освободились после переноса в after
+func (s *Service) offerVacatedTime(ctx context.Context, conn *pgx.Conn, resource bookingRepository.Resource, before, after bookingRepository.Booking) error {
+	for _, p := range freedPeriods(before, after) {
+		freed := bookingRepository.BookingDetails{
+			Booking:      bookingRepository.Booking{ID: before.ID, ResourceID: before.ResourceID, UserID: before.UserID, StartTime: p.Start, EndTime: p.End},
+			ResourceType: resource.Type,
+			ResourceName: resource.Name,
+		}
+		if err := s.offerFreedTime(ctx, conn, freed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExtendBooking продлевает запись пользователя на minutes минут, если следующее время свободно
+func (s *Service) ExtendBooking(ctx context.Context, bookingID, userID int, resourceType string, minutes int) (bookingRepository.Booking, error) {
+	if minutes <= 0 {
+		return bookingRepository.Booking{}, domainErrors.Validation("INVALID_MINUTES", "minutes must be positive",
+			domainErrors.FieldViolation{Field: "minutes", Description: "must be positive"})
+	}
+
+	return s.UpdateBooking(ctx, bookingID, userID, resourceType, BookingChange{ExtendMinutes: minutes})
+}
+
+// checkChangeAllowed проверяет, что запись еще можно изменить: закончившуюся запись
+// менять нельзя, а у начавшейся можно менять только время окончания
+func checkChangeAllowed(booking bookingRepository.Booking, resource bookingRepository.Resource, startTime, endTime, now time.Time) error {
+	if !endTime.After(startTime) {
+		return domainErrors.Validation("INVALID_TIME_RANGE", "end time must be after start time",
+			domainErrors.FieldViolation{Field: "end_time", Description: "must be after start_time"})
+	}
+
+	if !booking.EndTime.After(now) {
+		return domainErrors.Conflict("BOOKING_ENDED", "booking has already ended")
+	}
+
+	if booking.StartTime.After(now) {
+		return nil
+	}
+
+	if !startTime.Equal(booking.StartTime) || resource.ID != booking.ResourceID {
+		return domainErrors.Conflict("BOOKING_IN_PROGRESS", "booking has already started, only its end time can be changed")
+	}
+
+	if !endTime.After(now) {
+		return domainErrors.Validation("END_TIME_IN_PAST", "end time must be in the future",
+			domainErrors.FieldViolation{Field: "end_time", Description: "must be in the future"})
+	}
+
+	return nil
+}
+
+// freedPeriods возвращает части исходного времени записи, которые освободились после изменения
+func freedPeriods(before, after bookingRepository.Booking) []Period {
+	if before.ResourceID != after.ResourceID {
+		return []Period{{Start: before.StartTime, End: before.EndTime}}
+	}
+
+	var periods []Period
+	if after.StartTime.After(before.StartTime) {
+		end := after.StartTime
+		if end.After(before.EndTime) {
+			end = before.EndTime
+		}
+		periods = append(periods, Period{Start: before.StartTime, End: end})
+	}
+	if after.EndTime.Before(before.EndTime) {
+		start := after.EndTime
+		if start.Before(before.StartTime) {
+			start = before.StartTime
+		}
+		periods = append(periods, Period{Start: start, End: before.EndTime})
+	}
+
+	return periods
+}
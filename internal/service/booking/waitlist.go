@@ -0,0 +1,287 @@
+package bookingService
+
+import (
+	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/logging"
+	"dormitory-helper-service/internal/metrics"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	waitlistRepository "dormitory-helper-service/internal/repository/waitlist"
+	utilsService "dormitory-helper-service/internal/service/utils"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WaitlistRepository interface {
+	CreateEntry(ctx context.Context, conn *pgx.Conn, entry waitlistRepository.Entry) (int, error)
+	CountActiveEntries(ctx context.Context, conn *pgx.Conn, userID int) (int, error)
+	GetUserEntries(ctx context.Context, conn *pgx.Conn, userID int, limit int) ([]waitlistRepository.Entry, error)
+	GetEntryForUpdate(ctx context.Context, conn *pgx.Conn, entryID, userID int) (waitlistRepository.Entry, error)
+	GetWaitingCandidates(ctx context.Context, conn *pgx.Conn, resourceType string, resourceID int, startTime, endTime time.Time) ([]waitlistRepository.Entry, error)
+	MarkBooked(ctx context.Context, conn *pgx.Conn, entryID, bookingID int) error
+	MarkHeld(ctx context.Context, conn *pgx.Conn, entryID, bookingID int, holdUntil time.Time) error
+	SetStatus(ctx context.Context, conn *pgx.Conn, entryID int, status string) error
+	GetExpiredHolds(ctx context.Context, conn *pgx.Conn, now time.Time) ([]waitlistRepository.Entry, error)
+	ExpireWaiting(ctx context.Context, conn *pgx.Conn, now time.Time) (int, error)
+}
+
+const (
+	// maxWaitlistEntries сколько ожидающих и удерживаемых заявок может быть у пользователя
+	maxWaitlistEntries = 5
+	// waitlistHistoryLimit сколько последних заявок возвращается пользователю
+	waitlistHistoryLimit = 50
+)
+
+// JoinWaitlist добавляет пользователя в лист ожидания. Если DurationMinutes равен 0,
+// пользователь ждет все окно целиком (конкретный слот)
+func (s *Service) JoinWaitlist(ctx context.Context, entry waitlistRepository.Entry) (int, error) {
+	if !entry.WindowEnd.After(entry.WindowStart) {
+		return 0, domainErrors.Validation("INVALID_TIME_RANGE", "window end must be after window start",
+			domainErrors.FieldViolation{Field: "window_end", Description: "must be after window_start"})
+	}
+	if !entry.WindowEnd.After(time.Now()) {
+		return 0, domainErrors.Validation("WINDOW_IN_PAST", "waitlist window has already passed",
+			domainErrors.FieldViolation{Field: "window_end", Description: "must be in the future"})
+	}
+
+	windowMinutes := int(entry.WindowEnd.Sub(entry.WindowStart) / time.Minute)
+	if entry.DurationMinutes == 0 {
+		entry.DurationMinutes = windowMinutes
+	}
+	if entry.DurationMinutes <= 0 || entry.DurationMinutes > windowMinutes {
+		return 0, domainErrors.Validation("INVALID_DURATION", "duration must fit into the waitlist window",
+			domainErrors.FieldViolation{Field: "duration_minutes", Description: "must be positive and fit into the window"})
+	}
+
+	var entryID int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		if err := s.checkNotBanned(ctx, conn.Conn(), entry.UserID); err != nil {
+			return err
+		}
+
+		if err := s.repo.LockUser(ctx, conn.Conn(), entry.UserID); err != nil {
+			return err
+		}
+
+		if entry.ResourceID != 0 {
+			resource, err := s.repo.GetResourceByID(ctx, conn.Conn(), entry.ResourceID)
+			if err != nil {
+				return err
+			}
+			if entry.ResourceType != "" && entry.ResourceType != resource.Type {
+				return domainErrors.Validation("RESOURCE_TYPE_MISMATCH",
+					fmt.Sprintf("resource %d is not a %s resource", resource.ID, entry.ResourceType),
+					domainErrors.FieldViolation{Field: "resource_id", Description: "does not match resource_type"})
+			}
+			entry.ResourceType = resource.Type
+		} else {
+			resources, err := s.repo.GetResources(ctx, conn.Conn(), entry.ResourceType)
+			if err != nil {
+				return fmt.Errorf("failed to get resources: %w", err)
+			}
+			if entry.ResourceType == "" || len(resources) == 0 {
+				return domainErrors.NotFound("RESOURCE_NOT_FOUND", fmt.Sprintf("no active %s resource configured", entry.ResourceType))
+			}
+		}
+
+		active, err := s.waitlist.CountActiveEntries(ctx, conn.Conn(), entry.UserID)
+		if err != nil {
+			return err
+		}
+		if active >= maxWaitlistEntries {
+			return domainErrors.QuotaExceeded("WAITLIST_LIMIT",
+				fmt.Sprintf("at most %d waitlist entries are allowed", maxWaitlistEntries)).
+				WithMetadata("limit", strconv.Itoa(maxWaitlistEntries))
+		}
+
+		entryID, err = s.waitlist.CreateEntry(ctx, conn.Conn(), entry)
+		return err
+	})
+
+	return entryID, err
+}
+
+// GetMyWaitlist возвращает последние заявки пользователя в листе ожидания
+func (s *Service) GetMyWaitlist(ctx context.Context, userID int) ([]waitlistRepository.Entry, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	return s.waitlist.GetUserEntries(ctx, conn.Conn(), userID, waitlistHistoryLimit)
+}
+
+// LeaveWaitlist отменяет заявку. Удерживаемая запись освобождается
+// и предлагается следующему в очереди
+func (s *Service) LeaveWaitlist(ctx context.Context, entryID, userID int) error {
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		entry, err := s.waitlist.GetEntryForUpdate(ctx, conn.Conn(), entryID, userID)
+		if err != nil {
+			return err
+		}
+
+		switch entry.Status {
+		case waitlistRepository.StatusWaiting:
+		case waitlistRepository.StatusHeld:
+			if err := s.releaseHold(ctx, conn.Conn(), entry); err != nil {
+				return err
+			}
+		default:
+			return domainErrors.Conflict("WAITLIST_ENTRY_CLOSED", fmt.Sprintf("waitlist entry is already %s", entry.Status))
+		}
+
+		return s.waitlist.SetStatus(ctx, conn.Conn(), entry.ID, waitlistRepository.StatusCancelled)
+	})
+}
+
+// ConfirmWaitlistHold подтверждает удерживаемую запись. Возвращает ID записи
+func (s *Service) ConfirmWaitlistHold(ctx context.Context, entryID, userID int) (int, error) {
+	var bookingID int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		entry, err := s.waitlist.GetEntryForUpdate(ctx, conn.Conn(), entryID, userID)
+		if err != nil {
+			return err
+		}
+
+		if entry.Status != waitlistRepository.StatusHeld || entry.BookingID == 0 {
+			return domainErrors.Conflict("NOT_HELD", "waitlist entry has no booking on hold")
+		}
+		if entry.HoldUntil != nil && !entry.HoldUntil.After(time.Now()) {
+			return domainErrors.Conflict("HOLD_EXPIRED", "booking hold has expired")
+		}
+
+		bookingID = entry.BookingID
+		return s.waitlist.MarkBooked(ctx, conn.Conn(), entry.ID, entry.BookingID)
+	})
+
+	return bookingID, err
+}
+
+// ExpireWaitlist освобождает записи с истекшим удержанием, предлагая их следующим
+// в очереди, и закрывает заявки, окно которых прошло. Возвращает количество закрытых заявок
+func (s *Service) ExpireWaitlist(ctx context.Context) (int, error) {
+	var expired int
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		now := time.Now()
+
+		holds, err := s.waitlist.GetExpiredHolds(ctx, conn.Conn(), now)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range holds {
+			if err := s.releaseHold(ctx, conn.Conn(), entry); err != nil {
+				return err
+			}
+			if err := s.waitlist.SetStatus(ctx, conn.Conn(), entry.ID, waitlistRepository.StatusExpired); err != nil {
+				return err
+			}
+		}
+
+		waiting, err := s.waitlist.ExpireWaiting(ctx, conn.Conn(), now)
+		if err != nil {
+			return err
+		}
+
+		expired = len(holds) + waiting
+		return nil
+	})
+
+	return expired, err
+}
+
+// releaseHold удаляет удерживаемую для заявки запись и предлагает время следующему в очереди.
+// Если запись уже удалена (например, администратором), ничего не делает
+func (s *Service) releaseHold(ctx context.Context, conn *pgx.Conn, entry waitlistRepository.Entry) error {
+	if entry.BookingID == 0 {
+		return nil
+	}
+
+	freed, err := s.repo.DeleteBooking(ctx, conn, entry.BookingID, entry.UserID, "")
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrNotFound) || errors.Is(err, domainErrors.ErrForbidden) {
+			return nil
+		}
+		return err
+	}
+
+	metrics.BookingsDeleted.WithLabelValues(freed.ResourceType, metrics.DeleteReasonHoldReleased).Inc()
+	return s.offerFreedTime(ctx, conn, freed)
+}
+
+// OfferFreedTime предлагает листу ожидания время удаленной записи freed.
+// Вызывается в транзакции удаления
+func (s *Service) OfferFreedTime(ctx context.Context, conn *pgx.Conn, freed bookingRepository.BookingDetails) error {
+	return s.offerFreedTime(ctx, conn, freed)
+}
+
+// offerFreedTime выдает освободившееся время первому подходящему ожидающему в той же транзакции.
+// Заявка с auto_book получает подтвержденную запись, остальные - удержание до s.waitlistHold.
+// Ожидающие, которым запись создать нельзя (лимиты, тихие часы и т.д.), пропускаются
+func (s *Service) offerFreedTime(ctx context.Context, conn *pgx.Conn, freed bookingRepository.BookingDetails) error {
+	now := time.Now()
+	if !freed.EndTime.After(now) {
+		return nil
+	}
+
+	freeFrom := freed.StartTime
+	if freeFrom.Before(now) {
+		freeFrom = now
+	}
+
+	candidates, err := s.waitlist.GetWaitingCandidates(ctx, conn, freed.ResourceType, freed.ResourceID, freeFrom, freed.EndTime)
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	resource, err := s.repo.GetResourceByID(ctx, conn, freed.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range candidates {
+		startTime := entry.WindowStart
+		if startTime.Before(freeFrom) {
+			startTime = freeFrom
+		}
+		endTime := startTime.Add(time.Duration(entry.DurationMinutes) * time.Minute)
+
+		bookingID, err := s.tryCreateBooking(ctx, conn, entry.UserID, resource, startTime, endTime)
+		if err != nil {
+			if _, ok := domainErrors.As(err); ok {
+				slog.DebugContext(ctx, "waitlist entry skipped",
+					slog.Int("entry_id", entry.ID), logging.Err(err))
+				continue
+			}
+			return err
+		}
+
+		outcome := waitlistRepository.StatusHeld
+		if entry.AutoBook {
+			outcome = waitlistRepository.StatusBooked
+			err = s.waitlist.MarkBooked(ctx, conn, entry.ID, bookingID)
+		} else {
+			err = s.waitlist.MarkHeld(ctx, conn, entry.ID, bookingID, now.Add(s.waitlistHold))
+		}
+		if err != nil {
+			return err
+		}
+
+		metrics.WaitlistPromotions.WithLabelValues(resource.Type, outcome).Inc()
+		slog.InfoContext(ctx, "waitlist entry promoted",
+			slog.Int("entry_id", entry.ID), slog.Int("booking_id", bookingID), slog.String("status", outcome))
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package bookingService_test
+
+import (
+	"context"
+	"dormitory-helper-service/internal/migrator"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	waitlistRepository "dormitory-helper-service/internal/repository/waitlist"
+	bookingService "dormitory-helper-service/internal/service/booking"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// testDatabaseEnv переменная окружения с DSN тестовой базы. Без нее тесты
+// с настоящим Postgres пропускаются. Миграции применяются к этой базе
+const testDatabaseEnv = "TEST_DATABASE_URL"
+
+// waitlistHold сколько удерживается запись из листа ожидания в тестах
+const waitlistHold = 15 * time.Minute
+
+// openTestDB подключается к тестовой базе и применяет миграции
+func openTestDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testDatabaseEnv)
+	}
+
+	ctx := context.Background()
+	db, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(db.Close)
+
+	sqlDB := stdlib.OpenDBFromPool(db)
+	defer sqlDB.Close()
+
+	m, err := migrator.New(sqlDB)
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	return db
+}
+
+// waitlistFixture ресурс отдельного типа, его владелец и двое ожидающих. Тип у каждого
+// теста свой, чтобы лимиты и заявки других тестов не влияли на выбор ожидающего
+type waitlistFixture struct {
+	db       *pgxpool.Pool
+	service  *bookingService.Service
+	resource bookingRepository.Resource
+	owner    int
+	first    int
+	second   int
+	// start, end время записи владельца, которого ждут оба ожидающих
+	start, end time.Time
+}
+
+func newWaitlistFixture(t *testing.T, db *pgxpool.Pool) *waitlistFixture {
+	t.Helper()
+
+	ctx := context.Background()
+	suffix := time.Now().UnixNano()
+	resourceType := fmt.Sprintf("test_%d", suffix)
+
+	var resourceID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO resources (type, name, max_duration_minutes, capacity)
+		VALUES ($1, $1, 120, 1)
+		RETURNING id
+	`, resourceType).Scan(&resourceID)
+	if err != nil {
+		t.Fatalf("failed to create resource: %v", err)
+	}
+
+	prefix := fmt.Sprintf("test_%d_", suffix)
+	var users []int
+	rows, err := db.Query(ctx, `
+		INSERT INTO users (username)
+		SELECT $1 || i FROM generate_series(1, 3) AS i
+		RETURNING id
+	`, prefix)
+	if err != nil {
+		t.Fatalf("failed to create users: %v", err)
+	}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("failed to scan user: %v", err)
+		}
+		users = append(users, id)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("failed to create users: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_, _ = db.Exec(ctx, `DELETE FROM users WHERE username LIKE $1 || '%'`, prefix)
+		_, _ = db.Exec(ctx, `DELETE FROM resources WHERE id = $1`, resourceID)
+		_, _ = db.Exec(ctx, `DELETE FROM booking_quotas WHERE resource_type = $1`, resourceType)
+	})
+
+	service := bookingService.NewService(bookingRepository.NewRepository(), waitlistRepository.NewRepository(), db,
+		0, time.UTC, waitlistHold, bookingService.NoShowPolicy{}, 30*time.Minute)
+
+	resources, err := service.ListResources(ctx, resourceType)
+	if err != nil || len(resources) != 1 {
+		t.Fatalf("failed to get resource: %v", err)
+	}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	return &waitlistFixture{
+		db:       db,
+		service:  service,
+		resource: resources[0],
+		owner:    users[0],
+		first:    users[1],
+		second:   users[2],
+		start:    start,
+		end:      start.Add(time.Hour),
+	}
+}
+
+// book создает запись пользователя на ресурс
+func (f *waitlistFixture) book(t *testing.T, userID int, start, end time.Time) int {
+	t.Helper()
+
+	bookingID, err := f.service.CreateBooking(context.Background(), userID, f.resource.ID, start, end)
+	if err != nil {
+		t.Fatalf("failed to create booking for user %d: %v", userID, err)
+	}
+	return bookingID
+}
+
+// join ставит пользователя в очередь на время записи владельца
+func (f *waitlistFixture) join(t *testing.T, userID int, autoBook bool) {
+	t.Helper()
+
+	_, err := f.service.JoinWaitlist(context.Background(), waitlistRepository.Entry{
+		UserID:       userID,
+		ResourceType: f.resource.Type,
+		ResourceID:   f.resource.ID,
+		WindowStart:  f.start,
+		WindowEnd:    f.end,
+		AutoBook:     autoBook,
+	})
+	if err != nil {
+		t.Fatalf("failed to join waitlist for user %d: %v", userID, err)
+	}
+}
+
+// entry возвращает единственную заявку пользователя
+func (f *waitlistFixture) entry(t *testing.T, userID int) waitlistRepository.Entry {
+	t.Helper()
+
+	entries, err := f.service.GetMyWaitlist(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("failed to get waitlist of user %d: %v", userID, err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("user %d has %d waitlist entries, want 1", userID, len(entries))
+	}
+	return entries[0]
+}
+
+// checkEntry проверяет состояние заявки пользователя и наличие у нее записи
+func (f *waitlistFixture) checkEntry(t *testing.T, userID int, wantStatus string) {
+	t.Helper()
+
+	entry := f.entry(t, userID)
+	if entry.Status != wantStatus {
+		t.Errorf("user %d entry status = %s, want %s", userID, entry.Status, wantStatus)
+	}
+
+	wantBooking := wantStatus == waitlistRepository.StatusHeld || wantStatus == waitlistRepository.StatusBooked
+	if hasBooking := entry.BookingID != 0; hasBooking != wantBooking {
+		t.Errorf("user %d entry booking_id = %d, want booking: %v", userID, entry.BookingID, wantBooking)
+	}
+}
+
+func TestWaitlistDeletePromotesFirstWaiter(t *testing.T) {
+	f := newWaitlistFixture(t, openTestDB(t))
+	ctx := context.Background()
+
+	bookingID := f.book(t, f.owner, f.start, f.end)
+	f.join(t, f.first, true)
+	f.join(t, f.second, true)
+
+	if err := f.service.DeleteBooking(ctx, bookingID, f.owner, f.resource.Type); err != nil {
+		t.Fatalf("failed to delete booking: %v", err)
+	}
+
+	f.checkEntry(t, f.first, waitlistRepository.StatusBooked)
+	f.checkEntry(t, f.second, waitlistRepository.StatusWaiting)
+}
+
+func TestWaitlistSkipsWaiterOverQuota(t *testing.T) {
+	f := newWaitlistFixture(t, openTestDB(t))
+	ctx := context.Background()
+
+	_, err := f.db.Exec(ctx, `
+		INSERT INTO booking_quotas (resource_type, max_active_bookings) VALUES ($1, 1)
+	`, f.resource.Type)
+	if err != nil {
+		t.Fatalf("failed to create quota: %v", err)
+	}
+
+	bookingID := f.book(t, f.owner, f.start, f.end)
+	// Первый ожидающий уже исчерпал лимит активных записей
+	f.book(t, f.first, f.end.Add(time.Hour), f.end.Add(2*time.Hour))
+	f.join(t, f.first, true)
+	f.join(t, f.second, true)
+
+	if err := f.service.DeleteBooking(ctx, bookingID, f.owner, f.resource.Type); err != nil {
+		t.Fatalf("failed to delete booking: %v", err)
+	}
+
+	f.checkEntry(t, f.first, waitlistRepository.StatusWaiting)
+	f.checkEntry(t, f.second, waitlistRepository.StatusBooked)
+}
+
+func TestWaitlistExpiredHoldGoesToNextWaiter(t *testing.T) {
+	f := newWaitlistFixture(t, openTestDB(t))
+	ctx := context.Background()
+
+	bookingID := f.book(t, f.owner, f.start, f.end)
+	f.join(t, f.first, false)
+	f.join(t, f.second, false)
+
+	if err := f.service.DeleteBooking(ctx, bookingID, f.owner, f.resource.Type); err != nil {
+		t.Fatalf("failed to delete booking: %v", err)
+	}
+
+	held := f.entry(t, f.first)
+	if held.Status != waitlistRepository.StatusHeld {
+		t.Fatalf("first entry status = %s, want %s", held.Status, waitlistRepository.StatusHeld)
+	}
+	f.checkEntry(t, f.second, waitlistRepository.StatusWaiting)
+
+	// Первый ожидающий не подтвердил запись вовремя
+	_, err := f.db.Exec(ctx, `
+		UPDATE waitlist_entries SET hold_until = NOW() - interval '1 minute' WHERE id = $1
+	`, held.ID)
+	if err != nil {
+		t.Fatalf("failed to expire hold: %v", err)
+	}
+
+	if _, err := f.service.ExpireWaitlist(ctx); err != nil {
+		t.Fatalf("failed to expire waitlist: %v", err)
+	}
+
+	f.checkEntry(t, f.first, waitlistRepository.StatusExpired)
+	f.checkEntry(t, f.second, waitlistRepository.StatusHeld)
+}
@@ -0,0 +1,117 @@
+package kitchenService
+
+import (
+	"context"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
+	bookingService "dormitory-helper-service/internal/service/booking"
+	"time"
+)
+
+// resourceType тип ресурса в общем движке бронирования
+const resourceType = "kitchen"
+
+// BookingService общий движок бронирования ресурсов
+type BookingService interface {
+	ResolveResource(ctx context.Context, resourceType string) (bookingRepository.Resource, error)
+	CreateBooking(ctx context.Context, userID, resourceID int, startTime, endTime time.Time) (int, error)
+	GetBookings(ctx context.Context, filter bookingRepository.BookingFilter) ([]bookingRepository.Booking, error)
+	DeleteBooking(ctx context.Context, bookingID, userID int, resourceType string) error
+	UpdateBooking(ctx context.Context, bookingID, userID int, resourceType string, change bookingService.BookingChange) (bookingRepository.Booking, error)
+	ExtendBooking(ctx context.Context, bookingID, userID int, resourceType string, minutes int) (bookingRepository.Booking, error)
+	DateRange(fromDate, toDate string) (time.Time, time.Time, error)
+	FindAvailableSlots(ctx context.Context, query bookingService.SlotQuery) ([]bookingService.Slot, error)
+	FindNextAvailableSlot(ctx context.Context, query bookingService.SlotQuery) (bookingService.Slot, error)
+}
+
+// Service адаптер записей на кухню поверх общего движка бронирования
+type Service struct {
+	booking BookingService
+}
+
+func NewService(booking BookingService) *Service {
+	return &Service{
+		booking: booking,
+	}
+}
+
+// CreateKitchenBooking создает запись на кухню
+func (s *Service) CreateKitchenBooking(ctx context.Context, userID int, startTime, endTime time.Time) (int, error) {
+	resource, err := s.booking.ResolveResource(ctx, resourceType)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.booking.CreateBooking(ctx, userID, resource.ID, startTime, endTime)
+}
+
+// GetKitchenBookings получает все записи на кухню
+func (s *Service) GetKitchenBookings(ctx context.Context, startTime, endTime *time.Time) ([]bookingRepository.Booking, error) {
+	return s.booking.GetBookings(ctx, bookingRepository.BookingFilter{
+		ResourceType: resourceType,
+		StartTime:    startTime,
+		EndTime:      endTime,
+	})
+}
+
+// FindAvailableKitchenSlots возвращает свободные слоты на кухне длительностью duration,
+// начинающиеся с fromDate по toDate включительно (YYYY-MM-DD)
+func (s *Service) FindAvailableKitchenSlots(ctx context.Context, fromDate, toDate string, duration time.Duration, limit int) ([]bookingService.Slot, error) {
+	from, to, err := s.booking.DateRange(fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := s.booking.ResolveResource(ctx, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.booking.FindAvailableSlots(ctx, bookingService.SlotQuery{
+		ResourceType: resourceType,
+		ResourceIDs:  []int{resource.ID},
+		From:         from,
+		To:           to,
+		Duration:     duration,
+		Limit:        limit,
+	})
+}
+
+// FindNextKitchenSlot возвращает ближайший свободный слот на кухне длительностью duration
+func (s *Service) FindNextKitchenSlot(ctx context.Context, duration time.Duration) (bookingService.Slot, error) {
+	resource, err := s.booking.ResolveResource(ctx, resourceType)
+	if err != nil {
+		return bookingService.Slot{}, err
+	}
+
+	return s.booking.FindNextAvailableSlot(ctx, bookingService.SlotQuery{
+		ResourceType: resourceType,
+		ResourceIDs:  []int{resource.ID},
+		Duration:     duration,
+	})
+}
+
+// GetUserKitchenBookings получает все записи пользователя на кухню
+func (s *Service) GetUserKitchenBookings(ctx context.Context, userID int) ([]bookingRepository.Booking, error) {
+	return s.booking.GetBookings(ctx, bookingRepository.BookingFilter{
+		ResourceType: resourceType,
+		UserID:       userID,
+	})
+}
+
+// DeleteKitchenBooking удаляет запись на кухню
+func (s *Service) DeleteKitchenBooking(ctx context.Context, bookingID, userID int) error {
+	return s.booking.DeleteBooking(ctx, bookingID, userID, resourceType)
+}
+
+// UpdateKitchenBooking переносит запись на кухню или меняет ее длительность. Пустое время не меняется
+func (s *Service) UpdateKitchenBooking(ctx context.Context, bookingID, userID int, startTime, endTime *time.Time) (bookingRepository.Booking, error) {
+	return s.booking.UpdateBooking(ctx, bookingID, userID, resourceType, bookingService.BookingChange{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+}
+
+// ExtendKitchenBooking продлевает запись на кухню на minutes минут
+func (s *Service) ExtendKitchenBooking(ctx context.Context, bookingID, userID, minutes int) (bookingRepository.Booking, error) {
+	return s.booking.ExtendBooking(ctx, bookingID, userID, resourceType, minutes)
+}
@@ -2,8 +2,10 @@ package laundryService
 
 import (
 	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	bookingRepository "dormitory-helper-service/internal/repository/booking"
 	laundryRepository "dormitory-helper-service/internal/repository/laundry"
-	utilsService "dormitory-helper-service/internal/service/utils"
+	bookingService "dormitory-helper-service/internal/service/booking"
 	"fmt"
 	"time"
 
@@ -11,146 +13,264 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-type LaundryRepository interface {
-	CreateLaundryBooking(ctx context.Context, conn *pgx.Conn, userID int, startTime, endTime time.Time) (int, error)
-	GetLaundryBookings(ctx context.Context, conn *pgx.Conn, startTime, endTime *time.Time) ([]laundryRepository.Booking, error)
-	GetUserLaundryBookings(ctx context.Context, conn *pgx.Conn, userID int) ([]laundryRepository.Booking, error)
-	DeleteLaundryBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int) error
+// resourceType тип ресурса в общем движке бронирования
+const resourceType = "laundry"
+
+// BookingService общий движок бронирования ресурсов
+type BookingService interface {
+	CreateBooking(ctx context.Context, userID, resourceID int, startTime, endTime time.Time) (int, error)
+	TryCreateBooking(ctx context.Context, userID, resourceID int, startTime, endTime time.Time) (int, error)
+	GetBookings(ctx context.Context, filter bookingRepository.BookingFilter) ([]bookingRepository.Booking, error)
+	DeleteBooking(ctx context.Context, bookingID, userID int, resourceType string) error
+	UpdateBooking(ctx context.Context, bookingID, userID int, resourceType string, change bookingService.BookingChange) (bookingRepository.Booking, error)
+	ExtendBooking(ctx context.Context, bookingID, userID int, resourceType string, minutes int) (bookingRepository.Booking, error)
+	CheckIn(ctx context.Context, userID, bookingID int, resourceType, token string) (bookingRepository.Booking, error)
+	CheckOut(ctx context.Context, userID, bookingID int, resourceType string) (bookingRepository.Booking, error)
+	DateRange(fromDate, toDate string) (time.Time, time.Time, error)
+	FindAvailableSlots(ctx context.Context, query bookingService.SlotQuery) ([]bookingService.Slot, error)
+	FindNextAvailableSlot(ctx context.Context, query bookingService.SlotQuery) (bookingService.Slot, error)
+	SuggestAlternatives(ctx context.Context, conflict *domainErrors.Error, resourceIDs []int, startTime, endTime time.Time) *domainErrors.Error
+}
 
-	CreateKitchenBooking(ctx context.Context, conn *pgx.Conn, userID int, startTime, endTime time.Time) (int, error)
-	GetKitchenBookings(ctx context.Context, conn *pgx.Conn, startTime, endTime *time.Time) ([]laundryRepository.Booking, error)
-	GetUserKitchenBookings(ctx context.Context, conn *pgx.Conn, userID int) ([]laundryRepository.Booking, error)
-	DeleteKitchenBooking(ctx context.Context, conn *pgx.Conn, bookingID, userID int) error
+type LaundryRepository interface {
+	GetMachines(ctx context.Context, conn *pgx.Conn, machineType string) ([]laundryRepository.Machine, error)
+	GetMachineByID(ctx context.Context, conn *pgx.Conn, machineID int) (laundryRepository.Machine, error)
 }
 
+// Service адаптер записей на стирку поверх общего движка бронирования.
+// Каждая машина прачечной - отдельный ресурс, поэтому пересечения
+// проверяются в рамках одной машины
 type Service struct {
-	repo LaundryRepository
-	db   *pgxpool.Pool
+	booking BookingService
+	repo    LaundryRepository
+	db      *pgxpool.Pool
 }
 
-func NewService(repo LaundryRepository, db *pgxpool.Pool) *Service {
+func NewService(booking BookingService, repo LaundryRepository, db *pgxpool.Pool) *Service {
 	return &Service{
-		repo: repo,
-		db:   db,
+		booking: booking,
+		repo:    repo,
+		db:      db,
 	}
 }
 
-// CreateLaundryBooking создает запись на стирку
-func (s *Service) CreateLaundryBooking(ctx context.Context, userID int, startTime, endTime time.Time) (int, error) {
-	// Проверка длительности (максимум 2 часа)
-	if endTime.Sub(startTime) > 2*time.Hour {
-		return 0, fmt.Errorf("laundry booking duration cannot exceed 2 hours")
+// ListMachines возвращает машины прачечной заданного типа (или всех типов)
+func (s *Service) ListMachines(ctx context.Context, machineType string) ([]laundryRepository.Machine, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
 	}
+	defer conn.Release()
 
-	if endTime.Before(startTime) || endTime.Equal(startTime) {
-		return 0, fmt.Errorf("end time must be after start time")
+	machines, err := s.repo.GetMachines(ctx, conn.Conn(), machineType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get laundry machines: %w", err)
 	}
 
-	var bookingID int
-	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
-		var err error
-		bookingID, err = s.repo.CreateLaundryBooking(ctx, conn.Conn(), userID, startTime, endTime)
-		return err
-	})
-
-	return bookingID, err
+	return machines, nil
 }
 
-// GetLaundryBookings получает все записи на стирку
-func (s *Service) GetLaundryBookings(ctx context.Context, startTime, endTime *time.Time) ([]laundryRepository.Booking, error) {
-	conn, err := s.db.Acquire(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+// CreateLaundryBooking создает запись на стирку.
+// Если machineID равен 0, запись создается на первую свободную исправную машину типа machineType.
+// Возвращает ID записи и ID машины
+func (s *Service) CreateLaundryBooking(ctx context.Context, userID, machineID int, machineType string, startTime, endTime time.Time) (int, int, error) {
+	if machineID != 0 {
+		machine, err := s.getAvailableMachine(ctx, machineID)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		bookingID, err := s.booking.CreateBooking(ctx, userID, machine.ID, startTime, endTime)
+		return bookingID, machine.ID, err
+	}
+
+	if machineType == "" {
+		machineType = laundryRepository.MachineTypeWasher
 	}
-	defer conn.Release()
 
-	bookings, err := s.repo.GetLaundryBookings(ctx, conn.Conn(), startTime, endTime)
+	machines, err := s.ListMachines(ctx, machineType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get laundry bookings: %w", err)
+		return 0, 0, err
+	}
+
+	// Перебираем исправные машины, пока не найдется свободная. Альтернативы ищутся
+	// один раз по всем занятым машинам, а не после каждой попытки
+	var triedIDs []int
+	for _, machine := range machines {
+		if machine.Status != laundryRepository.MachineStatusAvailable {
+			continue
+		}
+
+		bookingID, err := s.booking.TryCreateBooking(ctx, userID, machine.ID, startTime, endTime)
+		if domainErr, ok := domainErrors.As(err); ok && domainErr.Reason == bookingRepository.ReasonOutOfOrder {
+			// Машина сломалась после получения списка
+			continue
+		}
+		if isUnavailable(err) {
+			triedIDs = append(triedIDs, machine.ID)
+			continue
+		}
+		return bookingID, machine.ID, err
 	}
 
-	return bookings, nil
+	conflict := domainErrors.Conflict("NO_FREE_MACHINE", fmt.Sprintf("no free %s for the selected time", machineType))
+	if len(triedIDs) == 0 {
+		return 0, 0, conflict
+	}
+	return 0, 0, s.booking.SuggestAlternatives(ctx, conflict, triedIDs, startTime, endTime)
 }
 
-// GetUserLaundryBookings получает все записи пользователя на стирку
-func (s *Service) GetUserLaundryBookings(ctx context.Context, userID int) ([]laundryRepository.Booking, error) {
+// isUnavailable проверяет, что время на машине занято или машина закрыта администратором
+func isUnavailable(err error) bool {
+	domainErr, ok := domainErrors.As(err)
+	return ok && (domainErr.Reason == bookingRepository.ErrTimeSlotBooked.Reason || domainErr.Reason == bookingRepository.ReasonBlackedOut)
+}
+
+// getAvailableMachine возвращает исправную машину прачечной по ID. Это предварительная
+// проверка для понятной ошибки: при записи движок проверяет состояние машины
+// еще раз в транзакции
+func (s *Service) getAvailableMachine(ctx context.Context, machineID int) (laundryRepository.Machine, error) {
 	conn, err := s.db.Acquire(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+		return laundryRepository.Machine{}, fmt.Errorf("failed to acquire connection: %w", err)
 	}
 	defer conn.Release()
 
-	bookings, err := s.repo.GetUserLaundryBookings(ctx, conn.Conn(), userID)
+	machine, err := s.repo.GetMachineByID(ctx, conn.Conn(), machineID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user laundry bookings: %w", err)
+		return laundryRepository.Machine{}, err
+	}
+	if machine.Status != laundryRepository.MachineStatusAvailable {
+		return laundryRepository.Machine{}, domainErrors.Conflict(bookingRepository.ReasonOutOfOrder, fmt.Sprintf("laundry machine %d is out of order", machineID))
 	}
 
-	return bookings, nil
+	return machine, nil
 }
 
-// DeleteLaundryBooking удаляет запись на стирку
-func (s *Service) DeleteLaundryBooking(ctx context.Context, bookingID, userID int) error {
-	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
-		return s.repo.DeleteLaundryBooking(ctx, conn.Conn(), bookingID, userID)
+// GetLaundryBookings получает все записи на стирку. Если machineID не равен 0 - только на эту машину
+func (s *Service) GetLaundryBookings(ctx context.Context, machineID int, startTime, endTime *time.Time) ([]bookingRepository.Booking, error) {
+	return s.booking.GetBookings(ctx, bookingRepository.BookingFilter{
+		ResourceID:   machineID,
+		ResourceType: resourceType,
+		StartTime:    startTime,
+		EndTime:      endTime,
 	})
 }
 
-// CreateKitchenBooking создает запись на кухню
-func (s *Service) CreateKitchenBooking(ctx context.Context, userID int, startTime, endTime time.Time) (int, error) {
-	// Проверка длительности (максимум 3 часа)
-	if endTime.Sub(startTime) > 3*time.Hour {
-		return 0, fmt.Errorf("kitchen booking duration cannot exceed 3 hours")
-	}
+// GetUserLaundryBookings получает все записи пользователя на стирку
+func (s *Service) GetUserLaundryBookings(ctx context.Context, userID int) ([]bookingRepository.Booking, error) {
+	return s.booking.GetBookings(ctx, bookingRepository.BookingFilter{
+		ResourceType: resourceType,
+		UserID:       userID,
+	})
+}
+
+// DeleteLaundryBooking удаляет запись на стирку
+func (s *Service) DeleteLaundryBooking(ctx context.Context, bookingID, userID int) error {
+	return s.booking.DeleteBooking(ctx, bookingID, userID, resourceType)
+}
 
-	if endTime.Before(startTime) || endTime.Equal(startTime) {
-		return 0, fmt.Errorf("end time must be after start time")
+// UpdateLaundryBooking переносит запись на стирку или меняет ее длительность.
+// Если machineID не равен 0, запись переносится на эту машину. Пустое время не меняется
+func (s *Service) UpdateLaundryBooking(ctx context.Context, bookingID, userID, machineID int, startTime, endTime *time.Time) (bookingRepository.Booking, error) {
+	if machineID != 0 {
+		if _, err := s.getAvailableMachine(ctx, machineID); err != nil {
+			return bookingRepository.Booking{}, err
+		}
 	}
 
-	var bookingID int
-	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
-		var err error
-		bookingID, err = s.repo.CreateKitchenBooking(ctx, conn.Conn(), userID, startTime, endTime)
-		return err
+	return s.booking.UpdateBooking(ctx, bookingID, userID, resourceType, bookingService.BookingChange{
+		ResourceID: machineID,
+		StartTime:  startTime,
+		EndTime:    endTime,
 	})
+}
 
-	return bookingID, err
+// ExtendLaundryBooking продлевает запись на стирку на minutes минут
+func (s *Service) ExtendLaundryBooking(ctx context.Context, bookingID, userID, minutes int) (bookingRepository.Booking, error) {
+	return s.booking.ExtendBooking(ctx, bookingID, userID, resourceType, minutes)
 }
 
-// GetKitchenBookings получает все записи на кухню
-func (s *Service) GetKitchenBookings(ctx context.Context, startTime, endTime *time.Time) ([]laundryRepository.Booking, error) {
-	conn, err := s.db.Acquire(ctx)
+// CheckInLaundryBooking отмечает приход на запись на стирку. Если token не пустой,
+// он должен быть кодом машины, на которую сделана запись
+func (s *Service) CheckInLaundryBooking(ctx context.Context, bookingID, userID int, token string) (bookingRepository.Booking, error) {
+	return s.booking.CheckIn(ctx, userID, bookingID, resourceType, token)
+}
+
+// CheckInLaundryMachine отмечает приход по коду машины на текущую запись пользователя на нее
+func (s *Service) CheckInLaundryMachine(ctx context.Context, userID int, token string) (bookingRepository.Booking, error) {
+	return s.booking.CheckIn(ctx, userID, 0, resourceType, token)
+}
+
+// CheckOutLaundryBooking отмечает уход со стирки
+func (s *Service) CheckOutLaundryBooking(ctx context.Context, bookingID, userID int) (bookingRepository.Booking, error) {
+	return s.booking.CheckOut(ctx, userID, bookingID, resourceType)
+}
+
+// FindAvailableLaundrySlots возвращает свободные слоты длительностью duration, начинающиеся
+// с fromDate по toDate включительно (YYYY-MM-DD). Если machineID равен 0, слоты ищутся
+// на всех исправных машинах типа machineType (или всех типов)
+func (s *Service) FindAvailableLaundrySlots(ctx context.Context, machineID int, machineType, fromDate, toDate string, duration time.Duration, limit int) ([]bookingService.Slot, error) {
+	from, to, err := s.booking.DateRange(fromDate, toDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+		return nil, err
 	}
-	defer conn.Release()
 
-	bookings, err := s.repo.GetKitchenBookings(ctx, conn.Conn(), startTime, endTime)
+	machineIDs, err := s.availableMachineIDs(ctx, machineID, machineType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get kitchen bookings: %w", err)
+		return nil, err
 	}
 
-	return bookings, nil
+	return s.booking.FindAvailableSlots(ctx, bookingService.SlotQuery{
+		ResourceType: resourceType,
+		ResourceIDs:  machineIDs,
+		From:         from,
+		To:           to,
+		Duration:     duration,
+		Limit:        limit,
+	})
 }
 
-// GetUserKitchenBookings получает все записи пользователя на кухню
-func (s *Service) GetUserKitchenBookings(ctx context.Context, userID int) ([]laundryRepository.Booking, error) {
-	conn, err := s.db.Acquire(ctx)
+// FindNextLaundrySlot возвращает ближайший свободный слот длительностью duration
+// на машине machineID или на любой исправной машине типа machineType
+func (s *Service) FindNextLaundrySlot(ctx context.Context, machineID int, machineType string, duration time.Duration) (bookingService.Slot, error) {
+	machineIDs, err := s.availableMachineIDs(ctx, machineID, machineType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+		return bookingService.Slot{}, err
 	}
-	defer conn.Release()
 
-	bookings, err := s.repo.GetUserKitchenBookings(ctx, conn.Conn(), userID)
+	return s.booking.FindNextAvailableSlot(ctx, bookingService.SlotQuery{
+		ResourceType: resourceType,
+		ResourceIDs:  machineIDs,
+		Duration:     duration,
+	})
+}
+
+// availableMachineIDs возвращает машину machineID, если она исправна,
+// или все исправные машины типа machineType
+func (s *Service) availableMachineIDs(ctx context.Context, machineID int, machineType string) ([]int, error) {
+	if machineID != 0 {
+		machine, err := s.getAvailableMachine(ctx, machineID)
+		if err != nil {
+			return nil, err
+		}
+		return []int{machine.ID}, nil
+	}
+
+	machines, err := s.ListMachines(ctx, machineType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user kitchen bookings: %w", err)
+		return nil, err
 	}
 
-	return bookings, nil
-}
+	var machineIDs []int
+	for _, machine := range machines {
+		if machine.Status == laundryRepository.MachineStatusAvailable {
+			machineIDs = append(machineIDs, machine.ID)
+		}
+	}
+	if len(machineIDs) == 0 {
+		return nil, domainErrors.Conflict("NO_AVAILABLE_MACHINE", "no laundry machine of this type is in working order")
+	}
 
-// DeleteKitchenBooking удаляет запись на кухню
-func (s *Service) DeleteKitchenBooking(ctx context.Context, bookingID, userID int) error {
-	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
-		return s.repo.DeleteKitchenBooking(ctx, conn.Conn(), bookingID, userID)
-	})
+	return machineIDs, nil
 }
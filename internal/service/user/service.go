@@ -2,9 +2,14 @@ package userService
 
 import (
 	"context"
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"dormitory-helper-service/internal/logging"
+	"dormitory-helper-service/internal/metrics"
 	utilsService "dormitory-helper-service/internal/service/utils"
 	jwtUtils "dormitory-helper-service/internal/utils/jwt"
+	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -18,19 +23,27 @@ type UserRepository interface {
 	GetUserByID(ctx context.Context, conn *pgx.Conn, userId int) (username string, err error)
 	CheckUserExpired(ctx context.Context, conn *pgx.Conn, userId int) (bool, error)
 	HasActiveBookings(ctx context.Context, conn *pgx.Conn, userId int) (bool, error)
+	GetUserRole(ctx context.Context, conn *pgx.Conn, userId int) (string, error)
+	SetUserRole(ctx context.Context, tx *pgx.Conn, userId int, role string) error
 }
 
 type Service struct {
 	repo      UserRepository
 	db        *pgxpool.Pool
 	jwtSecret []byte
+	// tokenTTL срок действия выдаваемых токенов
+	tokenTTL time.Duration
+	// userTTL время жизни автоматически созданного пользователя
+	userTTL time.Duration
 }
 
-func NewService(repo UserRepository, db *pgxpool.Pool, jwtSecret []byte) *Service {
+func NewService(repo UserRepository, db *pgxpool.Pool, jwtSecret []byte, tokenTTL, userTTL time.Duration) *Service {
 	return &Service{
 		repo:      repo,
 		db:        db,
 		jwtSecret: jwtSecret,
+		tokenTTL:  tokenTTL,
+		userTTL:   userTTL,
 	}
 }
 
@@ -46,13 +59,16 @@ func (s *Service) CreateUser(ctx context.Context, username string, TTL time.Dura
 		}
 
 		// Генерация JWT-токена
-		token, err = jwtUtils.GenerateToken(userId, username, s.jwtSecret)
+		token, err = jwtUtils.GenerateToken(userId, username, jwtUtils.RoleUser, s.jwtSecret, s.tokenTTL)
 		if err != nil {
 			return fmt.Errorf("failed to generate token: %w", err)
 		}
 
 		return nil
 	})
+	if err == nil {
+		metrics.UsersCreated.Inc()
+	}
 	return userId, token, err
 }
 
@@ -62,7 +78,9 @@ func (s *Service) CreateUser(ctx context.Context, username string, TTL time.Dura
 // 2. Если токен есть и валидный:
 //   - Если время жизни истекло И нет букингов - создаем нового пользователя
 //   - Если есть букинги - возвращаем существующего пользователя с текущим токеном
-func (s *Service) CheckAuthentication(ctx context.Context, token string) (userId int, username string, resultToken string, err error) {
+//
+// Если роль пользователя изменилась с момента выдачи токена, выдается новый токен с актуальной ролью
+func (s *Service) CheckAuthentication(ctx context.Context, token string) (userId int, username string, resultToken string, role string, err error) {
 	// Если токен пустой - создаем нового пользователя
 	if token == "" {
 		return s.createNewUser(ctx)
@@ -77,7 +95,7 @@ func (s *Service) CheckAuthentication(ctx context.Context, token string) (userId
 
 	conn, err := s.db.Acquire(ctx)
 	if err != nil {
-		return 0, "", "", fmt.Errorf("failed to acquire connection: %w", err)
+		return 0, "", "", "", fmt.Errorf("failed to acquire connection: %w", err)
 	}
 	defer conn.Release()
 
@@ -91,44 +109,91 @@ func (s *Service) CheckAuthentication(ctx context.Context, token string) (userId
 	// Проверяем, истек ли срок жизни пользователя
 	expired, err := s.repo.CheckUserExpired(ctx, conn.Conn(), claims.UserID)
 	if err != nil {
-		return 0, "", "", fmt.Errorf("failed to check user expiration: %w", err)
+		return 0, "", "", "", fmt.Errorf("failed to check user expiration: %w", err)
 	}
 
-	// Если время жизни не истекло - возвращаем текущего пользователя
-	if !expired {
-		return claims.UserID, claims.Username, token, nil
-	}
+	if expired {
+		// Время жизни истекло - проверяем букинги
+		hasBookings, err := s.repo.HasActiveBookings(ctx, conn.Conn(), claims.UserID)
+		if err != nil {
+			return 0, "", "", "", fmt.Errorf("failed to check bookings: %w", err)
+		}
 
-	// Время жизни истекло - проверяем букинги
-	hasBookings, err := s.repo.HasActiveBookings(ctx, conn.Conn(), claims.UserID)
-	if err != nil {
-		return 0, "", "", fmt.Errorf("failed to check bookings: %w", err)
+		// Нет букингов - удаляем старого и создаем нового пользователя
+		if !hasBookings {
+			if err := s.DeleteUser(ctx, claims.UserID); err != nil {
+				return 0, "", "", "", fmt.Errorf("failed to delete expired user: %w", err)
+			}
+			metrics.UsersExpired.Inc()
+
+			return s.createNewUser(ctx)
+		}
 	}
 
-	// Если есть букинги - возвращаем текущего пользователя с текущим токеном
-	if hasBookings {
-		return claims.UserID, claims.Username, token, nil
+	// Возвращаем текущего пользователя с текущим токеном
+	role, err = s.repo.GetUserRole(ctx, conn.Conn(), claims.UserID)
+	if err != nil {
+		return 0, "", "", "", fmt.Errorf("failed to get user role: %w", err)
 	}
 
-	// Нет букингов - удаляем старого и создаем нового пользователя
-	if err := s.DeleteUser(ctx, claims.UserID); err != nil {
-		return 0, "", "", fmt.Errorf("failed to delete expired user: %w", err)
+	if role != claims.Role {
+		token, err = jwtUtils.GenerateToken(claims.UserID, claims.Username, role, s.jwtSecret, s.tokenTTL)
+		if err != nil {
+			return 0, "", "", "", fmt.Errorf("failed to generate token: %w", err)
+		}
 	}
 
-	return s.createNewUser(ctx)
+	return claims.UserID, claims.Username, token, role, nil
 }
 
 // createNewUser создает нового пользователя с автоматически сгенерированным именем
-func (s *Service) createNewUser(ctx context.Context) (userId int, username string, token string, err error) {
+func (s *Service) createNewUser(ctx context.Context) (userId int, username string, token string, role string, err error) {
 	username = fmt.Sprintf("user_%d", time.Now().UnixNano())
-	TTL := 7 * 24 * time.Hour // 7 дней
+	userId, token, err = s.CreateUser(ctx, username, s.userTTL)
+	if err != nil {
+		return 0, "", "", "", fmt.Errorf("failed to create new user: %w", err)
+	}
+
+	return userId, username, token, jwtUtils.RoleUser, nil
+}
 
-	userId, token, err = s.CreateUser(ctx, username, TTL)
+// GetUserRole возвращает актуальную роль пользователя из базы данных
+func (s *Service) GetUserRole(ctx context.Context, userId int) (string, error) {
+	conn, err := s.db.Acquire(ctx)
 	if err != nil {
-		return 0, "", "", fmt.Errorf("failed to create new user: %w", err)
+		return "", fmt.Errorf("failed to acquire connection: %w", err)
 	}
+	defer conn.Release()
 
-	return userId, username, token, nil
+	return s.repo.GetUserRole(ctx, conn.Conn(), userId)
+}
+
+// SetUserRole назначает роль пользователю (повышение до администратора или понижение)
+func (s *Service) SetUserRole(ctx context.Context, userId int, role string) error {
+	if role != jwtUtils.RoleUser && role != jwtUtils.RoleAdmin {
+		return domainErrors.Validation("INVALID_ROLE", fmt.Sprintf("unknown role %q", role),
+			domainErrors.FieldViolation{Field: "role", Description: "must be user or admin"})
+	}
+
+	return utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		return s.repo.SetUserRole(ctx, conn.Conn(), userId, role)
+	})
+}
+
+// EnsureAdmins назначает роль администратора пользователям ids. Вызывается при запуске,
+// чтобы назначить первого администратора. Несуществующие пользователи пропускаются
+func (s *Service) EnsureAdmins(ctx context.Context, ids []int) error {
+	for _, id := range ids {
+		if err := s.SetUserRole(ctx, id, jwtUtils.RoleAdmin); err != nil {
+			if errors.Is(err, domainErrors.ErrNotFound) {
+				slog.WarnContext(ctx, "admin user not found", slog.Int("user_id", id))
+				continue
+			}
+			return fmt.Errorf("failed to grant admin role to user %d: %w", id, err)
+		}
+	}
+
+	return nil
 }
 
 // GetExpiredUsers возвращает список ID пользователей с истекшим временем жизни
@@ -158,6 +223,7 @@ func (s *Service) DeleteUser(ctx context.Context, userId int) error {
 }
 
 // CleanupExpiredUsers удаляет всех пользователей с истекшим временем жизни.
+// Пользователи с записями не удаляются, как и в CheckAuthentication.
 // Возвращает количество удаленных пользователей
 func (s *Service) CleanupExpiredUsers(ctx context.Context) (int, error) {
 	expiredUsers, err := s.GetExpiredUsers(ctx)
@@ -167,13 +233,38 @@ func (s *Service) CleanupExpiredUsers(ctx context.Context) (int, error) {
 
 	deletedCount := 0
 	for _, userId := range expiredUsers {
-		if err := s.DeleteUser(ctx, userId); err != nil {
+		deleted, err := s.deleteUserWithoutBookings(ctx, userId)
+		if err != nil {
 			// Логируем ошибку, но продолжаем удаление остальных
-			fmt.Printf("failed to delete user %d: %v\n", userId, err)
+			slog.ErrorContext(ctx, "failed to delete expired user", slog.Int("user_id", userId), logging.Err(err))
 			continue
 		}
-		deletedCount++
+		if deleted {
+			metrics.UsersExpired.Inc()
+			deletedCount++
+		}
 	}
 
 	return deletedCount, nil
 }
+
+// deleteUserWithoutBookings удаляет пользователя, если у него нет записей
+func (s *Service) deleteUserWithoutBookings(ctx context.Context, userId int) (bool, error) {
+	deleted := false
+	err := utilsService.WithTx(ctx, s.db, func(conn *pgxpool.Conn) error {
+		hasBookings, err := s.repo.HasActiveBookings(ctx, conn.Conn(), userId)
+		if err != nil {
+			return fmt.Errorf("failed to check bookings: %w", err)
+		}
+		if hasBookings {
+			return nil
+		}
+
+		if err := s.repo.DeleteUser(ctx, conn.Conn(), userId); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		deleted = true
+		return nil
+	})
+	return deleted, err
+}
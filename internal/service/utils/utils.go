@@ -2,13 +2,24 @@ package utilsService
 
 import (
 	"context"
+	"dormitory-helper-service/internal/tracing"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // WithTx выполняет функцию fn внутри транзакции
-func WithTx(ctx context.Context, db *pgxpool.Pool, fn func(conn *pgxpool.Conn) error) error {
+func WithTx(ctx context.Context, db *pgxpool.Pool, fn func(conn *pgxpool.Conn) error) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "db.transaction")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	conn, err := db.Acquire(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to acquire connection: %w", err)
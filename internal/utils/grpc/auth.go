@@ -1,45 +1,168 @@
 package grpcUtils
 
 import (
+	"context"
+	"dormitory-helper-service/internal/logging"
+	"dormitory-helper-service/internal/tracing"
 	jwtUtils "dormitory-helper-service/internal/utils/jwt"
 	"fmt"
+	"log/slog"
+	"strings"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-// ValidateTokenAndGetUserID валидирует JWT токен и возвращает user_id
-func ValidateTokenAndGetUserID(token string, jwtSecret []byte) (int, error) {
-	if token == "" {
+// authorizationHeader ключ метаданных с токеном (заголовок Authorization, пробрасываемый gateway)
+const authorizationHeader = "authorization"
+
+// bearerPrefix префикс токена в заголовке Authorization
+const bearerPrefix = "bearer "
+
+// Identity данные аутентифицированного пользователя в контексте запроса
+type Identity struct {
+	UserID   int
+	Username string
+	Role     string
+}
+
+type identityKey struct{}
+
+// ContextWithIdentity кладет данные пользователя в контекст
+func ContextWithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext возвращает данные пользователя из контекста
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// UserIDFromContext возвращает ID аутентифицированного пользователя
+// или codes.Unauthenticated, если запрос выполнен без токена
+func UserIDFromContext(ctx context.Context) (int, error) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
 		return 0, status.Errorf(codes.Unauthenticated, "token is required")
 	}
+	return identity.UserID, nil
+}
 
-	claims, err := jwtUtils.ValidateToken(token, jwtSecret)
-	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+// BearerTokenFromContext возвращает токен из заголовка "Authorization: Bearer <token>"
+func BearerTokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
 	}
 
-	if claims.UserID <= 0 {
-		return 0, status.Errorf(codes.Unauthenticated, "invalid user_id in token")
+	for _, value := range md.Get(authorizationHeader) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):])
+		}
+	}
+
+	return ""
+}
+
+// tokenRequest запросы с устаревшим полем token в теле
+type tokenRequest interface {
+	GetToken() string
+}
+
+// AuthUnaryInterceptor извлекает Bearer токен из метаданных, валидирует его
+// и кладет данные пользователя в контекст. Если заголовка нет, используется
+// устаревшее поле token из тела запроса.
+// Запросы без токена пропускаются: обработчики, которым нужен пользователь,
+// получают его через UserIDFromContext. Методы из publicMethods пропускаются
+// и с невалидным токеном (например, CheckAuthentication выдает новый токен)
+func AuthUnaryInterceptor(jwtSecret []byte, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		public[method] = true
 	}
 
-	return claims.UserID, nil
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		token := BearerTokenFromContext(ctx)
+		if token == "" {
+			if r, ok := req.(tokenRequest); ok {
+				token = r.GetToken()
+			}
+		}
+
+		if token == "" {
+			return handler(ctx, req)
+		}
+
+		_, span := tracing.Tracer().Start(ctx, "auth.validate_token")
+		identity, err := ValidateTokenAndGetIdentity(token, jwtSecret)
+		span.End()
+		if err != nil {
+			if public[info.FullMethod] {
+				return handler(ctx, req)
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+		}
+
+		logging.AddAttrs(ctx, slog.Int("user_id", identity.UserID))
+		ctx = ContextWithIdentity(ctx, identity)
+		return handler(ctx, req)
+	}
+}
+
+// RoleResolver возвращает актуальную роль пользователя
+type RoleResolver interface {
+	GetUserRole(ctx context.Context, userID int) (string, error)
 }
 
-// ValidateTokenAndGetUserInfo валидирует JWT токен и возвращает user_id и username
-func ValidateTokenAndGetUserInfo(token string, jwtSecret []byte) (userID int, username string, err error) {
+// RequireRoleUnaryInterceptor пропускает вызовы методов с префиксом methodPrefix
+// (например, "/admin.AdminService/") только для пользователей с ролью role.
+// Роль берется из базы данных, а не из токена, чтобы понижение роли действовало сразу
+func RequireRoleUnaryInterceptor(methodPrefix, role string, resolver RoleResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !strings.HasPrefix(info.FullMethod, methodPrefix) {
+			return handler(ctx, req)
+		}
+
+		identity, ok := IdentityFromContext(ctx)
+		if !ok {
+			return nil, status.Errorf(codes.Unauthenticated, "token is required")
+		}
+
+		actualRole, err := resolver.GetUserRole(ctx, identity.UserID)
+		if err != nil {
+			return nil, StatusFromError(err, "failed to check user role")
+		}
+		if actualRole != role {
+			return nil, status.Errorf(codes.PermissionDenied, "%s role is required", role)
+		}
+
+		identity.Role = actualRole
+		return handler(ContextWithIdentity(ctx, identity), req)
+	}
+}
+
+// ValidateTokenAndGetIdentity валидирует JWT токен и возвращает данные пользователя
+func ValidateTokenAndGetIdentity(token string, jwtSecret []byte) (Identity, error) {
 	if token == "" {
-		return 0, "", fmt.Errorf("token is required")
+		return Identity{}, fmt.Errorf("token is required")
 	}
 
 	claims, err := jwtUtils.ValidateToken(token, jwtSecret)
 	if err != nil {
-		return 0, "", fmt.Errorf("invalid token: %w", err)
+		return Identity{}, fmt.Errorf("invalid token: %w", err)
 	}
 
 	if claims.UserID <= 0 {
-		return 0, "", fmt.Errorf("invalid user_id in token")
+		return Identity{}, fmt.Errorf("invalid user_id in token")
+	}
+
+	role := claims.Role
+	if role == "" {
+		role = jwtUtils.RoleUser
 	}
 
-	return claims.UserID, claims.Username, nil
+	return Identity{UserID: claims.UserID, Username: claims.Username, Role: role}, nil
 }
@@ -0,0 +1,78 @@
+package grpcUtils
+
+import (
+	domainErrors "dormitory-helper-service/internal/domain/errors"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain домен ошибок в google.rpc.ErrorInfo
+const errorDomain = "dormitory-helper"
+
+// StatusFromError преобразует ошибку сервиса в gRPC статус.
+// Доменные ошибки получают соответствующий код и google.rpc детали
+// (ErrorInfo, BadRequest), остальные ошибки - codes.Internal с префиксом msg
+func StatusFromError(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	domainErr, ok := domainErrors.As(err)
+	if !ok {
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+
+	st := status.New(codeFromKind(domainErr.Kind()), domainErr.Message)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason:   domainErr.Reason,
+			Domain:   errorDomain,
+			Metadata: domainErr.Metadata,
+		},
+	}
+
+	if len(domainErr.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range domainErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	stWithDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return stWithDetails.Err()
+}
+
+// codeFromKind сопоставляет вид доменной ошибки с gRPC кодом
+func codeFromKind(kind error) codes.Code {
+	switch {
+	case errors.Is(kind, domainErrors.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(kind, domainErrors.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(kind, domainErrors.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(kind, domainErrors.ErrValidation):
+		return codes.InvalidArgument
+	case errors.Is(kind, domainErrors.ErrQuota):
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
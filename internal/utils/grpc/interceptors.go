@@ -0,0 +1,102 @@
+package grpcUtils
+
+import (
+	"context"
+	"dormitory-helper-service/internal/logging"
+	"dormitory-helper-service/internal/metrics"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDMetadataKey ключ метаданных с идентификатором запроса (gateway пробрасывает X-Request-Id)
+var requestIDMetadataKey = strings.ToLower(logging.RequestIDHeader)
+
+// RecoveryUnaryInterceptor перехватывает панику в обработчике и возвращает codes.Internal,
+// чтобы одна ошибка не останавливала весь сервер
+func RecoveryUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(ctx, "panic in handler",
+				slog.Any("panic", r),
+				slog.String("stack", string(debug.Stack())),
+			)
+			err = status.Errorf(codes.Internal, "internal server error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// MetricsUnaryInterceptor записывает длительность и код ответа каждого вызова.
+// Должен оборачивать Recovery, Auth и RequireRole, чтобы учитывать коды их ответов
+func MetricsUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	metrics.GRPCRequestDuration.
+		WithLabelValues(info.FullMethod, status.Code(err).String()).
+		Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// RequestIDUnaryInterceptor берет идентификатор запроса из метаданных или генерирует новый,
+// кладет его в контекст логирования вместе с именем метода и возвращает клиенту в заголовке.
+// Должен стоять первым в цепочке, чтобы идентификатор был в логах остальных интерцепторов
+func RequestIDUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	requestID = logging.RequestIDOrNew(requestID)
+
+	ctx = logging.NewRequestContext(ctx, requestID)
+	logging.AddAttrs(ctx, slog.String("rpc", info.FullMethod))
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, requestID)); err != nil {
+		slog.WarnContext(ctx, "failed to set request id header", logging.Err(err))
+	}
+
+	return handler(ctx, req)
+}
+
+// LoggingUnaryInterceptor пишет в лог результат каждого вызова. Ошибки сервера
+// (Internal, Unknown и т.п.) пишутся с уровнем Error вместе с исходной ошибкой
+func LoggingUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+	attrs := []slog.Attr{
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, logging.Err(err))
+	}
+
+	slog.LogAttrs(ctx, levelForCode(code), "rpc finished", attrs...)
+
+	return resp, err
+}
+
+// levelForCode уровень логирования для кода ответа
+func levelForCode(code codes.Code) slog.Level {
+	switch code {
+	case codes.OK:
+		return slog.LevelInfo
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
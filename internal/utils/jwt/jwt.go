@@ -7,20 +7,29 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Роли пользователей (тип user_role в базе данных)
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
 type Claims struct {
 	UserID   int    `json:"user_id"`
 	Username string `json:"username"`
+	Role     string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateToken создает JWT токен для пользователя
-func GenerateToken(userID int, username string, secretKey []byte) (string, error) {
+// GenerateToken создает JWT токен для пользователя со сроком действия ttl
+func GenerateToken(userID int, username, role string, secretKey []byte, ttl time.Duration) (string, error) {
+	now := time.Now()
 	claims := Claims{
 		UserID:   userID,
 		Username: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)), // 7 дней
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 
@@ -1,3 +1,4 @@
+-- +goose Up
 -- Создание таблицы пользователей
 CREATE TABLE IF NOT EXISTS users (
     id SERIAL PRIMARY KEY,
@@ -11,11 +12,13 @@ CREATE TABLE IF NOT EXISTS users_time_live (
 );
 
 -- Создание типа ролей
+-- +goose StatementBegin
 DO $$ BEGIN
     CREATE TYPE user_role AS ENUM ('user', 'admin');
 EXCEPTION
     WHEN duplicate_object THEN null;
 END $$;
+-- +goose StatementEnd
 
 -- Создание таблицы ролей
 CREATE TABLE IF NOT EXISTS roles (
@@ -45,4 +48,12 @@ CREATE TABLE IF NOT EXISTS kitchen_bookings (
     CONSTRAINT ch_kitchen_time_interval CHECK (
         end_time - start_time <= interval '3 hour'
     )
-);
\ No newline at end of file
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS kitchen_bookings;
+DROP TABLE IF EXISTS laundry_bookings;
+DROP TABLE IF EXISTS roles;
+DROP TYPE IF EXISTS user_role;
+DROP TABLE IF EXISTS users_time_live;
+DROP TABLE IF EXISTS users;
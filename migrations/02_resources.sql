@@ -0,0 +1,89 @@
+-- +goose Up
+-- Бронируемые ресурсы общежития (прачечная, кухня, душ, учебная комната и т.д.).
+-- Новый тип помещения добавляется вставкой строки, без изменения кода.
+CREATE TABLE IF NOT EXISTS resources (
+    id SERIAL PRIMARY KEY,
+    type VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    max_duration_minutes INTEGER NOT NULL,
+    capacity INTEGER NOT NULL DEFAULT 1,
+    rules JSONB NOT NULL DEFAULT '{}'::jsonb,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    CONSTRAINT ch_resources_max_duration CHECK (max_duration_minutes > 0),
+    CONSTRAINT ch_resources_capacity CHECK (capacity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_resources_type ON resources (type);
+
+-- Записи на любые ресурсы
+CREATE TABLE IF NOT EXISTS bookings (
+    id SERIAL PRIMARY KEY,
+    resource_id INTEGER NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users (id) ON DELETE CASCADE,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    CONSTRAINT ch_bookings_start_end_times CHECK (end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_resource_time ON bookings (resource_id, start_time, end_time);
+CREATE INDEX IF NOT EXISTS idx_bookings_user_id ON bookings (user_id);
+
+INSERT INTO resources (type, name, max_duration_minutes, capacity)
+VALUES
+    ('laundry', 'Прачечная', 120, 1),
+    ('kitchen', 'Кухня', 180, 1);
+
+-- Перенос существующих записей. Идентификаторы записей на стирку сохраняются,
+-- записи на кухню получают новые идентификаторы.
+INSERT INTO bookings (id, resource_id, user_id, start_time, end_time)
+SELECT lb.id, r.id, lb.user_id, lb.start_time, lb.end_time
+FROM laundry_bookings lb
+JOIN resources r ON r.type = 'laundry';
+
+SELECT setval(pg_get_serial_sequence('bookings', 'id'), COALESCE((SELECT MAX(id) FROM bookings), 0) + 1, false);
+
+INSERT INTO bookings (resource_id, user_id, start_time, end_time)
+SELECT r.id, kb.user_id, kb.start_time, kb.end_time
+FROM kitchen_bookings kb
+JOIN resources r ON r.type = 'kitchen';
+
+DROP TABLE IF EXISTS laundry_bookings;
+DROP TABLE IF EXISTS kitchen_bookings;
+
+-- +goose Down
+CREATE TABLE IF NOT EXISTS laundry_bookings (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users (id) ON DELETE CASCADE,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    CONSTRAINT ch_start_end_times CHECK (end_time > start_time),
+    CONSTRAINT ch_time_interval CHECK (
+        end_time - start_time <= interval '2 hours'
+    )
+);
+
+CREATE TABLE IF NOT EXISTS kitchen_bookings (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users (id) ON DELETE CASCADE,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    CONSTRAINT ch_kitchen_start_end_times CHECK (end_time > start_time),
+    CONSTRAINT ch_kitchen_time_interval CHECK (
+        end_time - start_time <= interval '3 hour'
+    )
+);
+
+INSERT INTO laundry_bookings (user_id, start_time, end_time)
+SELECT b.user_id, b.start_time, b.end_time
+FROM bookings b
+JOIN resources r ON r.id = b.resource_id
+WHERE r.type = 'laundry';
+
+INSERT INTO kitchen_bookings (user_id, start_time, end_time)
+SELECT b.user_id, b.start_time, b.end_time
+FROM bookings b
+JOIN resources r ON r.id = b.resource_id
+WHERE r.type = 'kitchen';
+
+DROP TABLE IF EXISTS bookings;
+DROP TABLE IF EXISTS resources;
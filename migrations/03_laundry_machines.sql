@@ -0,0 +1,39 @@
+-- +goose Up
+-- Создание типов стиральных машин и их состояния
+-- +goose StatementBegin
+DO $$ BEGIN
+    CREATE TYPE laundry_machine_type AS ENUM ('washer', 'dryer');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DO $$ BEGIN
+    CREATE TYPE laundry_machine_status AS ENUM ('available', 'out_of_order');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+-- +goose StatementEnd
+
+-- Машины прачечной. Каждая машина - отдельный бронируемый ресурс типа 'laundry',
+-- поэтому идентификатор машины совпадает с идентификатором ресурса.
+CREATE TABLE IF NOT EXISTS laundry_machines (
+    id INTEGER PRIMARY KEY REFERENCES resources (id) ON DELETE CASCADE,
+    type laundry_machine_type NOT NULL,
+    floor INTEGER NOT NULL,
+    status laundry_machine_status NOT NULL DEFAULT 'available'
+);
+
+-- Существующая прачечная становится первой стиральной машиной
+INSERT INTO laundry_machines (id, type, floor)
+SELECT id, 'washer', 1 FROM resources WHERE type = 'laundry';
+
+UPDATE resources SET name = 'Стиральная машина №1' WHERE type = 'laundry';
+
+-- +goose Down
+UPDATE resources SET name = 'Прачечная' WHERE type = 'laundry';
+
+DROP TABLE IF EXISTS laundry_machines;
+DROP TYPE IF EXISTS laundry_machine_status;
+DROP TYPE IF EXISTS laundry_machine_type;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Защита от двойного бронирования на уровне базы данных.
+-- Проверка пересечений в приложении (SELECT + INSERT) не защищает от
+-- конкурентных запросов, поэтому пересечения запрещаются ограничением EXCLUDE.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Номер единицы ресурса (плита, место и т.д.), занятой записью.
+-- Для ресурсов с capacity = 1 всегда равен 1.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS unit INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE bookings ADD CONSTRAINT ch_bookings_unit CHECK (unit > 0);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS period tstzrange
+    GENERATED ALWAYS AS (
+        tstzrange(start_time AT TIME ZONE 'UTC', end_time AT TIME ZONE 'UTC', '[)')
+    ) STORED;
+
+-- Если в таблице уже есть пересекающиеся записи, миграция завершится ошибкой,
+-- и их нужно будет разрешить вручную.
+ALTER TABLE bookings ADD CONSTRAINT ex_bookings_no_overlap
+    EXCLUDE USING gist (resource_id WITH =, unit WITH =, period WITH &&);
+
+-- +goose Down
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS ex_bookings_no_overlap;
+ALTER TABLE bookings DROP COLUMN IF EXISTS period;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS ch_bookings_unit;
+ALTER TABLE bookings DROP COLUMN IF EXISTS unit;
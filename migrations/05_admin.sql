@@ -0,0 +1,21 @@
+-- +goose Up
+-- В таблице ролей у пользователя может быть только одна роль.
+-- Столбец id ссылается на users (id), собственная последовательность ему не нужна.
+DELETE FROM roles a USING roles b WHERE a.ctid < b.ctid AND a.id = b.id;
+ALTER TABLE roles ALTER COLUMN id DROP DEFAULT;
+ALTER TABLE roles ADD CONSTRAINT roles_pkey PRIMARY KEY (id);
+
+-- Запреты на бронирование, выданные администраторами.
+-- banned_until = NULL означает бессрочный запрет.
+CREATE TABLE IF NOT EXISTS booking_bans (
+    user_id INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    banned_until TIMESTAMP,
+    created_by INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS booking_bans;
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS roles_pkey;
+ALTER TABLE roles ALTER COLUMN id SET DEFAULT nextval('roles_id_seq'::regclass);
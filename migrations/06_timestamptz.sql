@@ -0,0 +1,47 @@
+-- +goose Up
+-- Все моменты времени хранятся как timestamptz. Раньше столбцы были TIMESTAMP
+-- без часового пояса, а приложение записывало в них время в UTC, поэтому
+-- существующие значения интерпретируются как UTC.
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS ex_bookings_no_overlap;
+ALTER TABLE bookings DROP COLUMN IF EXISTS period;
+
+ALTER TABLE bookings
+    ALTER COLUMN start_time TYPE TIMESTAMPTZ USING start_time AT TIME ZONE 'UTC',
+    ALTER COLUMN end_time TYPE TIMESTAMPTZ USING end_time AT TIME ZONE 'UTC';
+
+ALTER TABLE bookings ADD COLUMN period tstzrange
+    GENERATED ALWAYS AS (tstzrange(start_time, end_time, '[)')) STORED;
+
+ALTER TABLE bookings ADD CONSTRAINT ex_bookings_no_overlap
+    EXCLUDE USING gist (resource_id WITH =, unit WITH =, period WITH &&);
+
+ALTER TABLE users_time_live
+    ALTER COLUMN time_live TYPE TIMESTAMPTZ USING time_live AT TIME ZONE 'UTC',
+    ALTER COLUMN time_live SET DEFAULT NOW() + interval '7 days';
+
+ALTER TABLE booking_bans
+    ALTER COLUMN banned_until TYPE TIMESTAMPTZ USING banned_until AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+
+-- +goose Down
+ALTER TABLE booking_bans
+    ALTER COLUMN banned_until TYPE TIMESTAMP USING banned_until AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC';
+
+ALTER TABLE users_time_live
+    ALTER COLUMN time_live TYPE TIMESTAMP USING time_live AT TIME ZONE 'UTC';
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS ex_bookings_no_overlap;
+ALTER TABLE bookings DROP COLUMN IF EXISTS period;
+
+ALTER TABLE bookings
+    ALTER COLUMN start_time TYPE TIMESTAMP USING start_time AT TIME ZONE 'UTC',
+    ALTER COLUMN end_time TYPE TIMESTAMP USING end_time AT TIME ZONE 'UTC';
+
+ALTER TABLE bookings ADD COLUMN period tstzrange
+    GENERATED ALWAYS AS (
+        tstzrange(start_time AT TIME ZONE 'UTC', end_time AT TIME ZONE 'UTC', '[)')
+    ) STORED;
+
+ALTER TABLE bookings ADD CONSTRAINT ex_bookings_no_overlap
+    EXCLUDE USING gist (resource_id WITH =, unit WITH =, period WITH &&);
@@ -0,0 +1,36 @@
+-- +goose Up
+-- Недельное расписание помещений. Окна задаются по местному времени общежития.
+-- open  - часы работы: если для типа ресурса задано хотя бы одно окно,
+--         запись должна целиком помещаться в часы работы; иначе помещение открыто круглосуточно.
+-- quiet - тихие часы: запись не должна их пересекать.
+-- Если end_time <= start_time, окно переходит через полночь (например, 23:00-07:00).
+-- +goose StatementBegin
+DO $$ BEGIN
+    CREATE TYPE facility_hours_kind AS ENUM ('open', 'quiet');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS facility_hours (
+    id SERIAL PRIMARY KEY,
+    resource_type VARCHAR(50) NOT NULL,
+    kind facility_hours_kind NOT NULL,
+    -- День недели начала окна: 0 - воскресенье, 6 - суббота
+    weekday SMALLINT NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    CONSTRAINT ch_facility_hours_weekday CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT ch_facility_hours_not_empty CHECK (start_time <> end_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_facility_hours_type ON facility_hours (resource_type);
+
+-- Тихие часы в прачечной каждый день с 23:00 до 07:00
+INSERT INTO facility_hours (resource_type, kind, weekday, start_time, end_time)
+SELECT 'laundry', 'quiet', d, '23:00', '07:00'
+FROM generate_series(0, 6) AS d;
+
+-- +goose Down
+DROP TABLE IF EXISTS facility_hours;
+DROP TYPE IF EXISTS facility_hours_kind;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- Периоды, когда ресурс закрыт администратором (ремонт, санобработка, праздники).
+-- Новые записи, пересекающие такой период, отклоняются.
+CREATE TABLE IF NOT EXISTS blackouts (
+    id SERIAL PRIMARY KEY,
+    resource_id INTEGER NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    period tstzrange GENERATED ALWAYS AS (tstzrange(start_time, end_time, '[)')) STORED,
+    CONSTRAINT ch_blackouts_start_end_times CHECK (end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_blackouts_resource_period ON blackouts USING gist (resource_id, period);
+
+-- Архив записей, отмененных не владельцем (например, из-за закрытия ресурса)
+CREATE TABLE IF NOT EXISTS booking_cancellations (
+    id SERIAL PRIMARY KEY,
+    booking_id INTEGER NOT NULL,
+    resource_id INTEGER NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users (id) ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    blackout_id INTEGER REFERENCES blackouts (id) ON DELETE SET NULL,
+    cancelled_by INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    cancelled_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_cancellations_user_id ON booking_cancellations (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS booking_cancellations;
+DROP TABLE IF EXISTS blackouts;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- Лимиты бронирования для одного пользователя по типу ресурса.
+-- 0 означает отсутствие ограничения.
+-- max_active_bookings   - сколько будущих (еще не закончившихся) записей можно иметь одновременно
+-- max_minutes_per_day   - суммарная длительность записей, начинающихся в один день
+-- max_minutes_per_week  - то же за неделю (с понедельника по местному времени общежития)
+-- min_gap_minutes       - минимальный перерыв между записями пользователя
+-- max_advance_minutes   - насколько далеко вперед можно записаться (переопределяет общую настройку)
+CREATE TABLE IF NOT EXISTS booking_quotas (
+    resource_type VARCHAR(50) PRIMARY KEY,
+    max_active_bookings INTEGER NOT NULL DEFAULT 0,
+    max_minutes_per_day INTEGER NOT NULL DEFAULT 0,
+    max_minutes_per_week INTEGER NOT NULL DEFAULT 0,
+    min_gap_minutes INTEGER NOT NULL DEFAULT 0,
+    max_advance_minutes INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT ch_booking_quotas_not_negative CHECK (
+        max_active_bookings >= 0 AND max_minutes_per_day >= 0 AND max_minutes_per_week >= 0
+        AND min_gap_minutes >= 0 AND max_advance_minutes >= 0
+    )
+);
+
+INSERT INTO booking_quotas (resource_type, max_active_bookings, max_minutes_per_day, max_minutes_per_week, min_gap_minutes, max_advance_minutes)
+VALUES
+    ('laundry', 3, 240, 600, 0, 7 * 24 * 60),
+    ('kitchen', 2, 180, 0, 60, 0);
+
+-- +goose Down
+DROP TABLE IF EXISTS booking_quotas;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- Лист ожидания занятого времени. Пользователь ждет запись длительностью
+-- duration_minutes в окне [window_start, window_end) на конкретный ресурс
+-- (resource_id) или на любой ресурс типа resource_type (resource_id = NULL).
+-- Когда запись удаляется, первый подходящий ожидающий получает запись:
+-- сразу (auto_book) или временно (held) до hold_until, после чего ее нужно подтвердить.
+-- +goose StatementBegin
+DO $$ BEGIN
+    CREATE TYPE waitlist_status AS ENUM ('waiting', 'held', 'booked', 'expired', 'cancelled');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    resource_type VARCHAR(50) NOT NULL,
+    resource_id INTEGER REFERENCES resources (id) ON DELETE CASCADE,
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    duration_minutes INTEGER NOT NULL,
+    auto_book BOOLEAN NOT NULL DEFAULT FALSE,
+    status waitlist_status NOT NULL DEFAULT 'waiting',
+    booking_id INTEGER REFERENCES bookings (id) ON DELETE SET NULL,
+    hold_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ch_waitlist_window CHECK (window_end > window_start),
+    CONSTRAINT ch_waitlist_duration CHECK (
+        duration_minutes > 0
+        AND window_start + duration_minutes * interval '1 minute' <= window_end
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_waiting ON waitlist_entries (resource_type, created_at)
+    WHERE status = 'waiting';
+CREATE INDEX IF NOT EXISTS idx_waitlist_held ON waitlist_entries (hold_until)
+    WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS idx_waitlist_user_id ON waitlist_entries (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS waitlist_entries;
+DROP TYPE IF EXISTS waitlist_status;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Повторяющиеся записи: каждую interval_weeks-ю неделю в то же время по часам
+-- общежития, начиная с first_start. Серия ограничена количеством повторений
+-- (occurrence_count) и/или моментом until: повторения, начинающиеся позже, не создаются.
+-- Повторения создаются сразу при создании серии как обычные записи с series_id
+CREATE TABLE IF NOT EXISTS booking_series (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    resource_id INTEGER NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    first_start TIMESTAMPTZ NOT NULL,
+    first_end TIMESTAMPTZ NOT NULL,
+    interval_weeks INTEGER NOT NULL DEFAULT 1,
+    occurrence_count INTEGER,
+    until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ch_series_time CHECK (first_end > first_start),
+    CONSTRAINT ch_series_interval CHECK (interval_weeks IN (1, 2)),
+    CONSTRAINT ch_series_bound CHECK (occurrence_count > 0 OR until IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_series_user_id ON booking_series (user_id);
+
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS series_id INTEGER REFERENCES booking_series (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_series_id ON bookings (series_id)
+    WHERE series_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bookings_series_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS booking_series;
@@ -0,0 +1,47 @@
+-- +goose Up
+-- Отметка о приходе и уходе. Если у ресурса в rules задан check_in_grace_minutes,
+-- запись без отметки освобождается через столько минут после начала (или после создания,
+-- если запись создана уже после начала) и считается неявкой.
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS checked_out_at TIMESTAMPTZ;
+
+-- Уже начавшиеся записи созданы до появления отметок и неявками не считаются
+UPDATE bookings SET checked_in_at = start_time WHERE start_time <= NOW();
+
+-- Токен из QR-кода на ресурсе (машине), которым можно отметиться без номера записи
+ALTER TABLE resources
+    ADD COLUMN IF NOT EXISTS check_in_token VARCHAR(64) UNIQUE;
+
+-- Неявки пользователей. Из них считается ограничение на бронирование
+CREATE TABLE IF NOT EXISTS booking_no_shows (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    booking_id INTEGER NOT NULL,
+    resource_id INTEGER NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_no_shows_user_id ON booking_no_shows (user_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_not_checked_in ON bookings (start_time)
+    WHERE checked_in_at IS NULL;
+
+-- Машины прачечной требуют отметки в течение 15 минут после начала записи
+UPDATE resources
+SET rules = rules || '{"check_in_grace_minutes": 15}'::jsonb
+WHERE type = 'laundry';
+
+-- +goose Down
+UPDATE resources SET rules = rules - 'check_in_grace_minutes';
+
+DROP INDEX IF EXISTS idx_bookings_not_checked_in;
+DROP TABLE IF EXISTS booking_no_shows;
+ALTER TABLE resources DROP COLUMN IF EXISTS check_in_token;
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS checked_out_at,
+    DROP COLUMN IF EXISTS checked_in_at,
+    DROP COLUMN IF EXISTS created_at;
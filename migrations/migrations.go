@@ -0,0 +1,8 @@
+// Package migrations содержит SQL миграции схемы в формате goose,
+// встроенные в бинарный файл
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS